@@ -7,6 +7,7 @@ import (
 	"context"
 	"sync/atomic"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
@@ -229,6 +230,38 @@ func (i *sfCounter) Add(ctx context.Context, incr float64, opts ...metric.AddOpt
 	}
 }
 
+func (i *sfCounter) Bind(attrs attribute.Set) metric.BoundFloat64Counter {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Float64Counter).Bind(attrs)
+	}
+	return &sfBoundCounter{inst: i, attrs: attrs}
+}
+
+// sfBoundCounter is bound to attrs before the delegate of inst
+// is set. It binds to the delegate of inst once it is set.
+type sfBoundCounter struct {
+	embedded.BoundFloat64Counter
+
+	inst  *sfCounter
+	attrs attribute.Set
+
+	delegate atomic.Value // metric.BoundFloat64Counter
+}
+
+var _ metric.BoundFloat64Counter = (*sfBoundCounter)(nil)
+
+func (b *sfBoundCounter) Add(ctx context.Context, incr float64) {
+	if ctr := b.delegate.Load(); ctr != nil {
+		ctr.(metric.BoundFloat64Counter).Add(ctx, incr)
+		return
+	}
+	if ctr := b.inst.delegate.Load(); ctr != nil {
+		bound := ctr.(metric.Float64Counter).Bind(b.attrs)
+		b.delegate.Store(bound)
+		bound.Add(ctx, incr)
+	}
+}
+
 type sfUpDownCounter struct {
 	embedded.Float64UpDownCounter
 
@@ -255,6 +288,38 @@ func (i *sfUpDownCounter) Add(ctx context.Context, incr float64, opts ...metric.
 	}
 }
 
+func (i *sfUpDownCounter) Bind(attrs attribute.Set) metric.BoundFloat64UpDownCounter {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Float64UpDownCounter).Bind(attrs)
+	}
+	return &sfBoundUpDownCounter{inst: i, attrs: attrs}
+}
+
+// sfBoundUpDownCounter is bound to attrs before the delegate of inst
+// is set. It binds to the delegate of inst once it is set.
+type sfBoundUpDownCounter struct {
+	embedded.BoundFloat64UpDownCounter
+
+	inst  *sfUpDownCounter
+	attrs attribute.Set
+
+	delegate atomic.Value // metric.BoundFloat64UpDownCounter
+}
+
+var _ metric.BoundFloat64UpDownCounter = (*sfBoundUpDownCounter)(nil)
+
+func (b *sfBoundUpDownCounter) Add(ctx context.Context, incr float64) {
+	if ctr := b.delegate.Load(); ctr != nil {
+		ctr.(metric.BoundFloat64UpDownCounter).Add(ctx, incr)
+		return
+	}
+	if ctr := b.inst.delegate.Load(); ctr != nil {
+		bound := ctr.(metric.Float64UpDownCounter).Bind(b.attrs)
+		b.delegate.Store(bound)
+		bound.Add(ctx, incr)
+	}
+}
+
 type sfHistogram struct {
 	embedded.Float64Histogram
 
@@ -281,6 +346,38 @@ func (i *sfHistogram) Record(ctx context.Context, x float64, opts ...metric.Reco
 	}
 }
 
+func (i *sfHistogram) Bind(attrs attribute.Set) metric.BoundFloat64Histogram {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Float64Histogram).Bind(attrs)
+	}
+	return &sfBoundHistogram{inst: i, attrs: attrs}
+}
+
+// sfBoundHistogram is bound to attrs before the delegate of inst
+// is set. It binds to the delegate of inst once it is set.
+type sfBoundHistogram struct {
+	embedded.BoundFloat64Histogram
+
+	inst  *sfHistogram
+	attrs attribute.Set
+
+	delegate atomic.Value // metric.BoundFloat64Histogram
+}
+
+var _ metric.BoundFloat64Histogram = (*sfBoundHistogram)(nil)
+
+func (b *sfBoundHistogram) Record(ctx context.Context, x float64) {
+	if ctr := b.delegate.Load(); ctr != nil {
+		ctr.(metric.BoundFloat64Histogram).Record(ctx, x)
+		return
+	}
+	if ctr := b.inst.delegate.Load(); ctr != nil {
+		bound := ctr.(metric.Float64Histogram).Bind(b.attrs)
+		b.delegate.Store(bound)
+		bound.Record(ctx, x)
+	}
+}
+
 type sfGauge struct {
 	embedded.Float64Gauge
 
@@ -307,6 +404,38 @@ func (i *sfGauge) Record(ctx context.Context, x float64, opts ...metric.RecordOp
 	}
 }
 
+func (i *sfGauge) Bind(attrs attribute.Set) metric.BoundFloat64Gauge {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Float64Gauge).Bind(attrs)
+	}
+	return &sfBoundGauge{inst: i, attrs: attrs}
+}
+
+// sfBoundGauge is bound to attrs before the delegate of inst
+// is set. It binds to the delegate of inst once it is set.
+type sfBoundGauge struct {
+	embedded.BoundFloat64Gauge
+
+	inst  *sfGauge
+	attrs attribute.Set
+
+	delegate atomic.Value // metric.BoundFloat64Gauge
+}
+
+var _ metric.BoundFloat64Gauge = (*sfBoundGauge)(nil)
+
+func (b *sfBoundGauge) Record(ctx context.Context, x float64) {
+	if ctr := b.delegate.Load(); ctr != nil {
+		ctr.(metric.BoundFloat64Gauge).Record(ctx, x)
+		return
+	}
+	if ctr := b.inst.delegate.Load(); ctr != nil {
+		bound := ctr.(metric.Float64Gauge).Bind(b.attrs)
+		b.delegate.Store(bound)
+		bound.Record(ctx, x)
+	}
+}
+
 type siCounter struct {
 	embedded.Int64Counter
 
@@ -333,6 +462,38 @@ func (i *siCounter) Add(ctx context.Context, x int64, opts ...metric.AddOption)
 	}
 }
 
+func (i *siCounter) Bind(attrs attribute.Set) metric.BoundInt64Counter {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Int64Counter).Bind(attrs)
+	}
+	return &siBoundCounter{inst: i, attrs: attrs}
+}
+
+// siBoundCounter is bound to attrs before the delegate of inst
+// is set. It binds to the delegate of inst once it is set.
+type siBoundCounter struct {
+	embedded.BoundInt64Counter
+
+	inst  *siCounter
+	attrs attribute.Set
+
+	delegate atomic.Value // metric.BoundInt64Counter
+}
+
+var _ metric.BoundInt64Counter = (*siBoundCounter)(nil)
+
+func (b *siBoundCounter) Add(ctx context.Context, x int64) {
+	if ctr := b.delegate.Load(); ctr != nil {
+		ctr.(metric.BoundInt64Counter).Add(ctx, x)
+		return
+	}
+	if ctr := b.inst.delegate.Load(); ctr != nil {
+		bound := ctr.(metric.Int64Counter).Bind(b.attrs)
+		b.delegate.Store(bound)
+		bound.Add(ctx, x)
+	}
+}
+
 type siUpDownCounter struct {
 	embedded.Int64UpDownCounter
 
@@ -359,6 +520,38 @@ func (i *siUpDownCounter) Add(ctx context.Context, x int64, opts ...metric.AddOp
 	}
 }
 
+func (i *siUpDownCounter) Bind(attrs attribute.Set) metric.BoundInt64UpDownCounter {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Int64UpDownCounter).Bind(attrs)
+	}
+	return &siBoundUpDownCounter{inst: i, attrs: attrs}
+}
+
+// siBoundUpDownCounter is bound to attrs before the delegate of inst
+// is set. It binds to the delegate of inst once it is set.
+type siBoundUpDownCounter struct {
+	embedded.BoundInt64UpDownCounter
+
+	inst  *siUpDownCounter
+	attrs attribute.Set
+
+	delegate atomic.Value // metric.BoundInt64UpDownCounter
+}
+
+var _ metric.BoundInt64UpDownCounter = (*siBoundUpDownCounter)(nil)
+
+func (b *siBoundUpDownCounter) Add(ctx context.Context, x int64) {
+	if ctr := b.delegate.Load(); ctr != nil {
+		ctr.(metric.BoundInt64UpDownCounter).Add(ctx, x)
+		return
+	}
+	if ctr := b.inst.delegate.Load(); ctr != nil {
+		bound := ctr.(metric.Int64UpDownCounter).Bind(b.attrs)
+		b.delegate.Store(bound)
+		bound.Add(ctx, x)
+	}
+}
+
 type siHistogram struct {
 	embedded.Int64Histogram
 
@@ -385,6 +578,38 @@ func (i *siHistogram) Record(ctx context.Context, x int64, opts ...metric.Record
 	}
 }
 
+func (i *siHistogram) Bind(attrs attribute.Set) metric.BoundInt64Histogram {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Int64Histogram).Bind(attrs)
+	}
+	return &siBoundHistogram{inst: i, attrs: attrs}
+}
+
+// siBoundHistogram is bound to attrs before the delegate of inst
+// is set. It binds to the delegate of inst once it is set.
+type siBoundHistogram struct {
+	embedded.BoundInt64Histogram
+
+	inst  *siHistogram
+	attrs attribute.Set
+
+	delegate atomic.Value // metric.BoundInt64Histogram
+}
+
+var _ metric.BoundInt64Histogram = (*siBoundHistogram)(nil)
+
+func (b *siBoundHistogram) Record(ctx context.Context, x int64) {
+	if ctr := b.delegate.Load(); ctr != nil {
+		ctr.(metric.BoundInt64Histogram).Record(ctx, x)
+		return
+	}
+	if ctr := b.inst.delegate.Load(); ctr != nil {
+		bound := ctr.(metric.Int64Histogram).Bind(b.attrs)
+		b.delegate.Store(bound)
+		bound.Record(ctx, x)
+	}
+}
+
 type siGauge struct {
 	embedded.Int64Gauge
 
@@ -410,3 +635,35 @@ func (i *siGauge) Record(ctx context.Context, x int64, opts ...metric.RecordOpti
 		ctr.(metric.Int64Gauge).Record(ctx, x, opts...)
 	}
 }
+
+func (i *siGauge) Bind(attrs attribute.Set) metric.BoundInt64Gauge {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(metric.Int64Gauge).Bind(attrs)
+	}
+	return &siBoundGauge{inst: i, attrs: attrs}
+}
+
+// siBoundGauge is bound to attrs before the delegate of inst
+// is set. It binds to the delegate of inst once it is set.
+type siBoundGauge struct {
+	embedded.BoundInt64Gauge
+
+	inst  *siGauge
+	attrs attribute.Set
+
+	delegate atomic.Value // metric.BoundInt64Gauge
+}
+
+var _ metric.BoundInt64Gauge = (*siBoundGauge)(nil)
+
+func (b *siBoundGauge) Record(ctx context.Context, x int64) {
+	if ctr := b.delegate.Load(); ctr != nil {
+		ctr.(metric.BoundInt64Gauge).Record(ctx, x)
+		return
+	}
+	if ctr := b.inst.delegate.Load(); ctr != nil {
+		bound := ctr.(metric.Int64Gauge).Bind(b.attrs)
+		b.delegate.Store(bound)
+		bound.Record(ctx, x)
+	}
+}
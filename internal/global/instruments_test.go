@@ -7,6 +7,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/metric/noop"
@@ -159,9 +162,6 @@ type testCountingFloatInstrument struct {
 
 	metric.Float64Observable
 	embedded.Float64Counter
-	embedded.Float64UpDownCounter
-	embedded.Float64Histogram
-	embedded.Float64Gauge
 	embedded.Float64ObservableCounter
 	embedded.Float64ObservableUpDownCounter
 	embedded.Float64ObservableGauge
@@ -179,14 +179,59 @@ func (i *testCountingFloatInstrument) Record(context.Context, float64, ...metric
 	i.count++
 }
 
+func (i *testCountingFloatInstrument) Bind(attribute.Set) metric.BoundFloat64Counter {
+	return &testCountingFloatBound{inst: i}
+}
+
+type testCountingFloatUpDownCounter struct {
+	*testCountingFloatInstrument
+	embedded.Float64UpDownCounter
+}
+
+func (i testCountingFloatUpDownCounter) Bind(attribute.Set) metric.BoundFloat64UpDownCounter {
+	return &testCountingFloatBound{inst: i.testCountingFloatInstrument}
+}
+
+type testCountingFloatHistogram struct {
+	*testCountingFloatInstrument
+	embedded.Float64Histogram
+}
+
+func (i testCountingFloatHistogram) Bind(attribute.Set) metric.BoundFloat64Histogram {
+	return &testCountingFloatBound{inst: i.testCountingFloatInstrument}
+}
+
+type testCountingFloatGauge struct {
+	*testCountingFloatInstrument
+	embedded.Float64Gauge
+}
+
+func (i testCountingFloatGauge) Bind(attribute.Set) metric.BoundFloat64Gauge {
+	return &testCountingFloatBound{inst: i.testCountingFloatInstrument}
+}
+
+type testCountingFloatBound struct {
+	embedded.BoundFloat64Counter
+	embedded.BoundFloat64UpDownCounter
+	embedded.BoundFloat64Histogram
+	embedded.BoundFloat64Gauge
+
+	inst *testCountingFloatInstrument
+}
+
+func (b *testCountingFloatBound) Add(context.Context, float64) {
+	b.inst.count++
+}
+
+func (b *testCountingFloatBound) Record(context.Context, float64) {
+	b.inst.count++
+}
+
 type testCountingIntInstrument struct {
 	count int
 
 	metric.Int64Observable
 	embedded.Int64Counter
-	embedded.Int64UpDownCounter
-	embedded.Int64Histogram
-	embedded.Int64Gauge
 	embedded.Int64ObservableCounter
 	embedded.Int64ObservableUpDownCounter
 	embedded.Int64ObservableGauge
@@ -203,3 +248,155 @@ func (i *testCountingIntInstrument) Add(context.Context, int64, ...metric.AddOpt
 func (i *testCountingIntInstrument) Record(context.Context, int64, ...metric.RecordOption) {
 	i.count++
 }
+
+func (i *testCountingIntInstrument) Bind(attribute.Set) metric.BoundInt64Counter {
+	return &testCountingIntBound{inst: i}
+}
+
+type testCountingIntUpDownCounter struct {
+	*testCountingIntInstrument
+	embedded.Int64UpDownCounter
+}
+
+func (i testCountingIntUpDownCounter) Bind(attribute.Set) metric.BoundInt64UpDownCounter {
+	return &testCountingIntBound{inst: i.testCountingIntInstrument}
+}
+
+type testCountingIntHistogram struct {
+	*testCountingIntInstrument
+	embedded.Int64Histogram
+}
+
+func (i testCountingIntHistogram) Bind(attribute.Set) metric.BoundInt64Histogram {
+	return &testCountingIntBound{inst: i.testCountingIntInstrument}
+}
+
+type testCountingIntGauge struct {
+	*testCountingIntInstrument
+	embedded.Int64Gauge
+}
+
+func (i testCountingIntGauge) Bind(attribute.Set) metric.BoundInt64Gauge {
+	return &testCountingIntBound{inst: i.testCountingIntInstrument}
+}
+
+type testCountingIntBound struct {
+	embedded.BoundInt64Counter
+	embedded.BoundInt64UpDownCounter
+	embedded.BoundInt64Histogram
+	embedded.BoundInt64Gauge
+
+	inst *testCountingIntInstrument
+}
+
+func (b *testCountingIntBound) Add(context.Context, int64) {
+	b.inst.count++
+}
+
+func (b *testCountingIntBound) Record(context.Context, int64) {
+	b.inst.count++
+}
+
+func TestSyncInstrumentBindDelegate(t *testing.T) {
+	ctx := context.Background()
+	attrs := attribute.NewSet(attribute.String("key", "value"))
+	m := &testMeter{}
+
+	t.Run("Float64", func(t *testing.T) {
+		t.Run("Counter", func(t *testing.T) {
+			inst := &sfCounter{}
+			bound := inst.Bind(attrs)
+			bound.Add(ctx, 1) // Dropped, no delegate.
+			inst.setDelegate(m)
+			bound.Add(ctx, 1)
+			bound.Add(ctx, 1)
+			ctr := inst.delegate.Load().(*testCountingFloatInstrument)
+			assert.Equal(t, 2, ctr.count)
+		})
+
+		t.Run("UpDownCounter", func(t *testing.T) {
+			inst := &sfUpDownCounter{}
+			bound := inst.Bind(attrs)
+			bound.Add(ctx, 1)
+			inst.setDelegate(m)
+			bound.Add(ctx, 1)
+			ctr := inst.delegate.Load().(testCountingFloatUpDownCounter)
+			assert.Equal(t, 1, ctr.count)
+		})
+
+		t.Run("Histogram", func(t *testing.T) {
+			inst := &sfHistogram{}
+			bound := inst.Bind(attrs)
+			bound.Record(ctx, 1)
+			inst.setDelegate(m)
+			bound.Record(ctx, 1)
+			ctr := inst.delegate.Load().(testCountingFloatHistogram)
+			assert.Equal(t, 1, ctr.count)
+		})
+
+		t.Run("Gauge", func(t *testing.T) {
+			inst := &sfGauge{}
+			bound := inst.Bind(attrs)
+			bound.Record(ctx, 1)
+			inst.setDelegate(m)
+			bound.Record(ctx, 1)
+			ctr := inst.delegate.Load().(testCountingFloatGauge)
+			assert.Equal(t, 1, ctr.count)
+		})
+
+		t.Run("BindAfterDelegate", func(t *testing.T) {
+			inst := &sfCounter{}
+			inst.setDelegate(m)
+			assert.IsType(t, &testCountingFloatBound{}, inst.Bind(attrs))
+		})
+	})
+
+	t.Run("Int64", func(t *testing.T) {
+		t.Run("Counter", func(t *testing.T) {
+			inst := &siCounter{}
+			bound := inst.Bind(attrs)
+			bound.Add(ctx, 1) // Dropped, no delegate.
+			inst.setDelegate(m)
+			bound.Add(ctx, 1)
+			bound.Add(ctx, 1)
+			ctr := inst.delegate.Load().(*testCountingIntInstrument)
+			assert.Equal(t, 2, ctr.count)
+		})
+
+		t.Run("UpDownCounter", func(t *testing.T) {
+			inst := &siUpDownCounter{}
+			bound := inst.Bind(attrs)
+			bound.Add(ctx, 1)
+			inst.setDelegate(m)
+			bound.Add(ctx, 1)
+			ctr := inst.delegate.Load().(testCountingIntUpDownCounter)
+			assert.Equal(t, 1, ctr.count)
+		})
+
+		t.Run("Histogram", func(t *testing.T) {
+			inst := &siHistogram{}
+			bound := inst.Bind(attrs)
+			bound.Record(ctx, 1)
+			inst.setDelegate(m)
+			bound.Record(ctx, 1)
+			ctr := inst.delegate.Load().(testCountingIntHistogram)
+			assert.Equal(t, 1, ctr.count)
+		})
+
+		t.Run("Gauge", func(t *testing.T) {
+			inst := &siGauge{}
+			bound := inst.Bind(attrs)
+			bound.Record(ctx, 1)
+			inst.setDelegate(m)
+			bound.Record(ctx, 1)
+			ctr := inst.delegate.Load().(testCountingIntGauge)
+			assert.Equal(t, 1, ctr.count)
+		})
+
+		t.Run("BindAfterDelegate", func(t *testing.T) {
+			inst := &siCounter{}
+			inst.setDelegate(m)
+			assert.IsType(t, &testCountingIntBound{}, inst.Bind(attrs))
+		})
+	})
+}
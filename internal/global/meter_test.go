@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/noop"
 )
@@ -411,3 +412,64 @@ func TestMeterIdentity(t *testing.T) {
 		}
 	}
 }
+
+type adviceMeterProvider struct {
+	noop.MeterProvider
+
+	meter *adviceMeter
+}
+
+func (p adviceMeterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return p.meter
+}
+
+// adviceMeter records the configuration of the instruments it creates.
+type adviceMeter struct {
+	noop.Meter
+
+	histogram metric.Float64HistogramConfig
+	counter   metric.Int64CounterConfig
+	gauge     metric.Int64ObservableGaugeConfig
+}
+
+func (m *adviceMeter) Float64Histogram(name string, options ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	m.histogram = metric.NewFloat64HistogramConfig(options...)
+	return m.Meter.Float64Histogram(name, options...)
+}
+
+func (m *adviceMeter) Int64Counter(name string, options ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	m.counter = metric.NewInt64CounterConfig(options...)
+	return m.Meter.Int64Counter(name, options...)
+}
+
+func (m *adviceMeter) Int64ObservableGauge(name string, options ...metric.Int64ObservableGaugeOption) (metric.Int64ObservableGauge, error) {
+	m.gauge = metric.NewInt64ObservableGaugeConfig(options...)
+	return m.Meter.Int64ObservableGauge(name, options...)
+}
+
+func TestMeterDelegatesAdvice(t *testing.T) {
+	bounds := []float64{1, 5, 10}
+	keys := []attribute.Key{"user"}
+
+	globalMeterProvider := &meterProvider{}
+	m := globalMeterProvider.Meter("go.opentelemetry.io/otel/metric/internal/global/meter_test")
+
+	_, err := m.Float64Histogram(
+		"test.histogram",
+		metric.WithExplicitBucketBoundaries(bounds...),
+		metric.WithAttributeKeys(keys...),
+	)
+	require.NoError(t, err)
+	_, err = m.Int64Counter("test.counter", metric.WithAttributeKeys(keys...))
+	require.NoError(t, err)
+	_, err = m.Int64ObservableGauge("test.gauge", metric.WithAttributeKeys(keys...))
+	require.NoError(t, err)
+
+	delegate := &adviceMeter{}
+	globalMeterProvider.setDelegate(adviceMeterProvider{meter: delegate})
+
+	assert.Equal(t, bounds, delegate.histogram.ExplicitBucketBoundaries(), "histogram boundaries")
+	assert.Equal(t, keys, delegate.histogram.AttributeKeys(), "histogram attribute keys")
+	assert.Equal(t, keys, delegate.counter.AttributeKeys(), "counter attribute keys")
+	assert.Equal(t, keys, delegate.gauge.AttributeKeys(), "gauge attribute keys")
+}
@@ -6,6 +6,7 @@ package metric // import "go.opentelemetry.io/otel/metric"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
 
@@ -41,9 +42,10 @@ type Float64ObservableCounter interface {
 // Float64ObservableCounterConfig contains options for asynchronous counter
 // instruments that record float64 values.
 type Float64ObservableCounterConfig struct {
-	description string
-	unit        string
-	callbacks   []Float64Callback
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
+	callbacks     []Float64Callback
 }
 
 // NewFloat64ObservableCounterConfig returns a new
@@ -66,6 +68,12 @@ func (c Float64ObservableCounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. It is nil if
+// no keys are advised, and empty if dropping all attributes is advised.
+func (c Float64ObservableCounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Callbacks returns the configured callbacks.
 func (c Float64ObservableCounterConfig) Callbacks() []Float64Callback {
 	return c.callbacks
@@ -99,9 +107,10 @@ type Float64ObservableUpDownCounter interface {
 // Float64ObservableUpDownCounterConfig contains options for asynchronous
 // counter instruments that record float64 values.
 type Float64ObservableUpDownCounterConfig struct {
-	description string
-	unit        string
-	callbacks   []Float64Callback
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
+	callbacks     []Float64Callback
 }
 
 // NewFloat64ObservableUpDownCounterConfig returns a new
@@ -124,6 +133,12 @@ func (c Float64ObservableUpDownCounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. It is nil if
+// no keys are advised, and empty if dropping all attributes is advised.
+func (c Float64ObservableUpDownCounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Callbacks returns the configured callbacks.
 func (c Float64ObservableUpDownCounterConfig) Callbacks() []Float64Callback {
 	return c.callbacks
@@ -156,9 +171,10 @@ type Float64ObservableGauge interface {
 // Float64ObservableGaugeConfig contains options for asynchronous counter
 // instruments that record float64 values.
 type Float64ObservableGaugeConfig struct {
-	description string
-	unit        string
-	callbacks   []Float64Callback
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
+	callbacks     []Float64Callback
 }
 
 // NewFloat64ObservableGaugeConfig returns a new [Float64ObservableGaugeConfig]
@@ -181,6 +197,12 @@ func (c Float64ObservableGaugeConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. It is nil if
+// no keys are advised, and empty if dropping all attributes is advised.
+func (c Float64ObservableGaugeConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Callbacks returns the configured callbacks.
 func (c Float64ObservableGaugeConfig) Callbacks() []Float64Callback {
 	return c.callbacks
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
 
@@ -19,11 +20,13 @@ func TestFloat64ObservableConfiguration(t *testing.T) {
 		desc           = "Instrument description."
 		uBytes         = "By"
 	)
+	keys := []attribute.Key{"user", "admin"}
 
 	run := func(got float64ObservableConfig) func(*testing.T) {
 		return func(t *testing.T) {
 			assert.Equal(t, desc, got.Description(), "description")
 			assert.Equal(t, uBytes, got.Unit(), "unit")
+			assert.Equal(t, keys, got.AttributeKeys(), "attribute keys")
 
 			// Functions are not comparable.
 			cBacks := got.Callbacks()
@@ -44,6 +47,7 @@ func TestFloat64ObservableConfiguration(t *testing.T) {
 		NewFloat64ObservableCounterConfig(
 			WithDescription(desc),
 			WithUnit(uBytes),
+			WithAttributeKeys(keys...),
 			WithFloat64Callback(cback),
 		),
 	))
@@ -52,6 +56,7 @@ func TestFloat64ObservableConfiguration(t *testing.T) {
 		NewFloat64ObservableUpDownCounterConfig(
 			WithDescription(desc),
 			WithUnit(uBytes),
+			WithAttributeKeys(keys...),
 			WithFloat64Callback(cback),
 		),
 	))
@@ -60,6 +65,7 @@ func TestFloat64ObservableConfiguration(t *testing.T) {
 		NewFloat64ObservableGaugeConfig(
 			WithDescription(desc),
 			WithUnit(uBytes),
+			WithAttributeKeys(keys...),
 			WithFloat64Callback(cback),
 		),
 	))
@@ -68,6 +74,7 @@ func TestFloat64ObservableConfiguration(t *testing.T) {
 type float64ObservableConfig interface {
 	Description() string
 	Unit() string
+	AttributeKeys() []attribute.Key
 	Callbacks() []Float64Callback
 }
 
@@ -29,6 +29,12 @@ All synchronous instruments ([Int64Counter], [Int64UpDownCounter],
 code during the source code execution. These instruments only make measurements
 when the source code they instrument is run.
 
+Synchronous instruments can be bound to a fixed attribute set with their Bind
+method. Measurements made with the returned bound instrument (e.g.
+[BoundInt64Counter]) are made for that attribute set. Implementations can use
+this to resolve the attribute set once instead of for each measurement, which
+is beneficial on hot code paths that repeatedly measure the same attributes.
+
 All asynchronous instruments ([Int64ObservableCounter],
 [Int64ObservableUpDownCounter], [Int64ObservableGauge],
 [Float64ObservableCounter], [Float64ObservableUpDownCounter], and
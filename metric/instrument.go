@@ -205,6 +205,96 @@ func (o bucketOpt) applyInt64Histogram(c Int64HistogramConfig) Int64HistogramCon
 	return c
 }
 
+// WithAttributeKeys sets the advisory attribute keys of the instrument. They
+// are the recommended keys of the measurement attributes to keep when the
+// measurements are aggregated. Attributes with other keys are expected to be
+// dropped, unless the aggregation is configured otherwise. Calling
+// WithAttributeKeys without keys advises to drop all attributes, not using
+// the option at all gives no advice.
+//
+// The keys are copied, later changes to the passed slice have no effect.
+//
+// This option is considered "advisory", and may be ignored by API implementations.
+func WithAttributeKeys(keys ...attribute.Key) InstrumentOption {
+	// Copy into a non-nil slice: a nil variadic slice would be
+	// indistinguishable from no advice.
+	k := make([]attribute.Key, len(keys))
+	copy(k, keys)
+	return attrKeysOpt(k)
+}
+
+type attrKeysOpt []attribute.Key
+
+func (o attrKeysOpt) applyFloat64Counter(c Float64CounterConfig) Float64CounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64UpDownCounter(c Float64UpDownCounterConfig) Float64UpDownCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64Histogram(c Float64HistogramConfig) Float64HistogramConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64Gauge(c Float64GaugeConfig) Float64GaugeConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64ObservableCounter(c Float64ObservableCounterConfig) Float64ObservableCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64ObservableUpDownCounter(c Float64ObservableUpDownCounterConfig) Float64ObservableUpDownCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64ObservableGauge(c Float64ObservableGaugeConfig) Float64ObservableGaugeConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64Counter(c Int64CounterConfig) Int64CounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64UpDownCounter(c Int64UpDownCounterConfig) Int64UpDownCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64Histogram(c Int64HistogramConfig) Int64HistogramConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64Gauge(c Int64GaugeConfig) Int64GaugeConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64ObservableCounter(c Int64ObservableCounterConfig) Int64ObservableCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64ObservableUpDownCounter(c Int64ObservableUpDownCounterConfig) Int64ObservableUpDownCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64ObservableGauge(c Int64ObservableGaugeConfig) Int64ObservableGaugeConfig {
+	c.attributeKeys = o
+	return c
+}
+
 // AddOption applies options to an addition measurement. See
 // [MeasurementOption] for other options that can be used as an AddOption.
 type AddOption interface {
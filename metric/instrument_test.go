@@ -139,3 +139,16 @@ func TestWithAttributesConcurrentSafe(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestWithAttributeKeys(t *testing.T) {
+	assert.Nil(t, NewInt64CounterConfig().AttributeKeys(), "no advice")
+
+	got := NewInt64CounterConfig(WithAttributeKeys()).AttributeKeys()
+	assert.NotNil(t, got, "drop all attributes advice")
+	assert.Empty(t, got)
+
+	keys := []attribute.Key{"a", "b"}
+	cfg := NewFloat64HistogramConfig(WithAttributeKeys(keys...))
+	keys[0] = "c"
+	assert.Equal(t, []attribute.Key{"a", "b"}, cfg.AttributeKeys(), "keys should be copied")
+}
@@ -35,34 +35,66 @@ func TestImplementationNoPanics(t *testing.T) {
 		reflect.ValueOf(Int64Counter{}),
 		reflect.TypeOf((*metric.Int64Counter)(nil)).Elem(),
 	))
+	t.Run("BoundInt64Counter", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundInt64Counter{}),
+		reflect.TypeOf((*metric.BoundInt64Counter)(nil)).Elem(),
+	))
 	t.Run("Float64Counter", assertAllExportedMethodNoPanic(
 		reflect.ValueOf(Float64Counter{}),
 		reflect.TypeOf((*metric.Float64Counter)(nil)).Elem(),
 	))
+	t.Run("BoundFloat64Counter", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundFloat64Counter{}),
+		reflect.TypeOf((*metric.BoundFloat64Counter)(nil)).Elem(),
+	))
 	t.Run("Int64UpDownCounter", assertAllExportedMethodNoPanic(
 		reflect.ValueOf(Int64UpDownCounter{}),
 		reflect.TypeOf((*metric.Int64UpDownCounter)(nil)).Elem(),
 	))
+	t.Run("BoundInt64UpDownCounter", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundInt64UpDownCounter{}),
+		reflect.TypeOf((*metric.BoundInt64UpDownCounter)(nil)).Elem(),
+	))
 	t.Run("Float64UpDownCounter", assertAllExportedMethodNoPanic(
 		reflect.ValueOf(Float64UpDownCounter{}),
 		reflect.TypeOf((*metric.Float64UpDownCounter)(nil)).Elem(),
 	))
+	t.Run("BoundFloat64UpDownCounter", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundFloat64UpDownCounter{}),
+		reflect.TypeOf((*metric.BoundFloat64UpDownCounter)(nil)).Elem(),
+	))
 	t.Run("Int64Histogram", assertAllExportedMethodNoPanic(
 		reflect.ValueOf(Int64Histogram{}),
 		reflect.TypeOf((*metric.Int64Histogram)(nil)).Elem(),
 	))
+	t.Run("BoundInt64Histogram", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundInt64Histogram{}),
+		reflect.TypeOf((*metric.BoundInt64Histogram)(nil)).Elem(),
+	))
 	t.Run("Float64Histogram", assertAllExportedMethodNoPanic(
 		reflect.ValueOf(Float64Histogram{}),
 		reflect.TypeOf((*metric.Float64Histogram)(nil)).Elem(),
 	))
+	t.Run("BoundFloat64Histogram", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundFloat64Histogram{}),
+		reflect.TypeOf((*metric.BoundFloat64Histogram)(nil)).Elem(),
+	))
 	t.Run("Int64Gauge", assertAllExportedMethodNoPanic(
 		reflect.ValueOf(Int64Gauge{}),
 		reflect.TypeOf((*metric.Int64Gauge)(nil)).Elem(),
 	))
+	t.Run("BoundInt64Gauge", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundInt64Gauge{}),
+		reflect.TypeOf((*metric.BoundInt64Gauge)(nil)).Elem(),
+	))
 	t.Run("Float64Gauge", assertAllExportedMethodNoPanic(
 		reflect.ValueOf(Float64Gauge{}),
 		reflect.TypeOf((*metric.Float64Gauge)(nil)).Elem(),
 	))
+	t.Run("BoundFloat64Gauge", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundFloat64Gauge{}),
+		reflect.TypeOf((*metric.BoundFloat64Gauge)(nil)).Elem(),
+	))
 	t.Run("Int64ObservableCounter", assertAllExportedMethodNoPanic(
 		reflect.ValueOf(Int64ObservableCounter{}),
 		reflect.TypeOf((*metric.Int64ObservableCounter)(nil)).Elem(),
@@ -6,6 +6,7 @@ package metric // import "go.opentelemetry.io/otel/metric"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
 
@@ -25,13 +26,21 @@ type Float64Counter interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Add(ctx context.Context, incr float64, options ...AddOption)
+
+	// Bind returns a BoundFloat64Counter that records measurements with the
+	// attributes of attrs.
+	//
+	// Use it to record measurements with a constant attribute set, the
+	// attributes are only resolved once instead of for each measurement.
+	Bind(attrs attribute.Set) BoundFloat64Counter
 }
 
 // Float64CounterConfig contains options for synchronous counter instruments that
 // record float64 values.
 type Float64CounterConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewFloat64CounterConfig returns a new [Float64CounterConfig] with all opts
@@ -54,6 +63,12 @@ func (c Float64CounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. It is nil if
+// no keys are advised, and empty if dropping all attributes is advised.
+func (c Float64CounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Float64CounterOption applies options to a [Float64CounterConfig]. See
 // [InstrumentOption] for other options that can be used as a
 // Float64CounterOption.
@@ -61,6 +76,22 @@ type Float64CounterOption interface {
 	applyFloat64Counter(Float64CounterConfig) Float64CounterConfig
 }
 
+// BoundFloat64Counter is a Float64Counter bound to a fixed attribute set. It is
+// returned by the Bind method of a Float64Counter.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundFloat64Counter interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundFloat64Counter
+
+	// Add records a change to the counter with the bound attributes.
+	Add(ctx context.Context, incr float64)
+}
+
 // Float64UpDownCounter is an instrument that records increasing or decreasing
 // float64 values.
 //
@@ -78,13 +109,21 @@ type Float64UpDownCounter interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Add(ctx context.Context, incr float64, options ...AddOption)
+
+	// Bind returns a BoundFloat64UpDownCounter that records measurements with
+	// the attributes of attrs.
+	//
+	// Use it to record measurements with a constant attribute set, the
+	// attributes are only resolved once instead of for each measurement.
+	Bind(attrs attribute.Set) BoundFloat64UpDownCounter
 }
 
 // Float64UpDownCounterConfig contains options for synchronous counter
 // instruments that record float64 values.
 type Float64UpDownCounterConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewFloat64UpDownCounterConfig returns a new [Float64UpDownCounterConfig]
@@ -107,6 +146,12 @@ func (c Float64UpDownCounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. It is nil if
+// no keys are advised, and empty if dropping all attributes is advised.
+func (c Float64UpDownCounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Float64UpDownCounterOption applies options to a
 // [Float64UpDownCounterConfig]. See [InstrumentOption] for other options that
 // can be used as a Float64UpDownCounterOption.
@@ -114,6 +159,22 @@ type Float64UpDownCounterOption interface {
 	applyFloat64UpDownCounter(Float64UpDownCounterConfig) Float64UpDownCounterConfig
 }
 
+// BoundFloat64UpDownCounter is a Float64UpDownCounter bound to a fixed
+// attribute set. It is returned by the Bind method of a Float64UpDownCounter.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundFloat64UpDownCounter interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundFloat64UpDownCounter
+
+	// Add records a change to the counter with the bound attributes.
+	Add(ctx context.Context, incr float64)
+}
+
 // Float64Histogram is an instrument that records a distribution of float64
 // values.
 //
@@ -131,6 +192,13 @@ type Float64Histogram interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Record(ctx context.Context, incr float64, options ...RecordOption)
+
+	// Bind returns a BoundFloat64Histogram that records measurements with the
+	// attributes of attrs.
+	//
+	// Use it to record measurements with a constant attribute set, the
+	// attributes are only resolved once instead of for each measurement.
+	Bind(attrs attribute.Set) BoundFloat64Histogram
 }
 
 // Float64HistogramConfig contains options for synchronous histogram
@@ -138,6 +206,7 @@ type Float64Histogram interface {
 type Float64HistogramConfig struct {
 	description              string
 	unit                     string
+	attributeKeys            []attribute.Key
 	explicitBucketBoundaries []float64
 }
 
@@ -161,6 +230,12 @@ func (c Float64HistogramConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. It is nil if
+// no keys are advised, and empty if dropping all attributes is advised.
+func (c Float64HistogramConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // ExplicitBucketBoundaries returns the configured explicit bucket boundaries.
 func (c Float64HistogramConfig) ExplicitBucketBoundaries() []float64 {
 	return c.explicitBucketBoundaries
@@ -173,6 +248,23 @@ type Float64HistogramOption interface {
 	applyFloat64Histogram(Float64HistogramConfig) Float64HistogramConfig
 }
 
+// BoundFloat64Histogram is a Float64Histogram bound to a fixed attribute set.
+// It is returned by the Bind method of a Float64Histogram.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundFloat64Histogram interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundFloat64Histogram
+
+	// Record adds an additional value to the distribution with the bound
+	// attributes.
+	Record(ctx context.Context, incr float64)
+}
+
 // Float64Gauge is an instrument that records instantaneous float64 values.
 //
 // Warning: Methods may be added to this interface in minor releases. See
@@ -189,13 +281,21 @@ type Float64Gauge interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Record(ctx context.Context, value float64, options ...RecordOption)
+
+	// Bind returns a BoundFloat64Gauge that records measurements with the
+	// attributes of attrs.
+	//
+	// Use it to record measurements with a constant attribute set, the
+	// attributes are only resolved once instead of for each measurement.
+	Bind(attrs attribute.Set) BoundFloat64Gauge
 }
 
 // Float64GaugeConfig contains options for synchronous gauge instruments that
 // record float64 values.
 type Float64GaugeConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewFloat64GaugeConfig returns a new [Float64GaugeConfig] with all opts
@@ -218,9 +318,31 @@ func (c Float64GaugeConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. It is nil if
+// no keys are advised, and empty if dropping all attributes is advised.
+func (c Float64GaugeConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Float64GaugeOption applies options to a [Float64GaugeConfig]. See
 // [InstrumentOption] for other options that can be used as a
 // Float64GaugeOption.
 type Float64GaugeOption interface {
 	applyFloat64Gauge(Float64GaugeConfig) Float64GaugeConfig
 }
+
+// BoundFloat64Gauge is a Float64Gauge bound to a fixed attribute set. It is
+// returned by the Bind method of a Float64Gauge.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundFloat64Gauge interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundFloat64Gauge
+
+	// Record records the instantaneous value with the bound attributes.
+	Record(ctx context.Context, value float64)
+}
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func TestFloat64Configuration(t *testing.T) {
@@ -15,34 +17,37 @@ func TestFloat64Configuration(t *testing.T) {
 		desc           = "Instrument description."
 		uBytes         = "By"
 	)
+	keys := []attribute.Key{"user", "admin"}
 
 	run := func(got float64Config) func(*testing.T) {
 		return func(t *testing.T) {
 			assert.Equal(t, desc, got.Description(), "description")
 			assert.Equal(t, uBytes, got.Unit(), "unit")
+			assert.Equal(t, keys, got.AttributeKeys(), "attribute keys")
 		}
 	}
 
 	t.Run("Float64Counter", run(
-		NewFloat64CounterConfig(WithDescription(desc), WithUnit(uBytes)),
+		NewFloat64CounterConfig(WithDescription(desc), WithUnit(uBytes), WithAttributeKeys(keys...)),
 	))
 
 	t.Run("Float64UpDownCounter", run(
-		NewFloat64UpDownCounterConfig(WithDescription(desc), WithUnit(uBytes)),
+		NewFloat64UpDownCounterConfig(WithDescription(desc), WithUnit(uBytes), WithAttributeKeys(keys...)),
 	))
 
 	t.Run("Float64Histogram", run(
-		NewFloat64HistogramConfig(WithDescription(desc), WithUnit(uBytes)),
+		NewFloat64HistogramConfig(WithDescription(desc), WithUnit(uBytes), WithAttributeKeys(keys...)),
 	))
 
 	t.Run("Float64Gauge", run(
-		NewFloat64GaugeConfig(WithDescription(desc), WithUnit(uBytes)),
+		NewFloat64GaugeConfig(WithDescription(desc), WithUnit(uBytes), WithAttributeKeys(keys...)),
 	))
 }
 
 type float64Config interface {
 	Description() string
 	Unit() string
+	AttributeKeys() []attribute.Key
 }
 
 func TestFloat64ExplicitBucketHistogramConfiguration(t *testing.T) {
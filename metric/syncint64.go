@@ -6,6 +6,7 @@ package metric // import "go.opentelemetry.io/otel/metric"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
 
@@ -25,13 +26,21 @@ type Int64Counter interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Add(ctx context.Context, incr int64, options ...AddOption)
+
+	// Bind returns a BoundInt64Counter that records measurements with the
+	// attributes of attrs.
+	//
+	// Use it to record measurements with a constant attribute set, the
+	// attributes are only resolved once instead of for each measurement.
+	Bind(attrs attribute.Set) BoundInt64Counter
 }
 
 // Int64CounterConfig contains options for synchronous counter instruments that
 // record int64 values.
 type Int64CounterConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewInt64CounterConfig returns a new [Int64CounterConfig] with all opts
@@ -54,6 +63,12 @@ func (c Int64CounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. It is nil if
+// no keys are advised, and empty if dropping all attributes is advised.
+func (c Int64CounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Int64CounterOption applies options to a [Int64CounterConfig]. See
 // [InstrumentOption] for other options that can be used as an
 // Int64CounterOption.
@@ -61,6 +76,22 @@ type Int64CounterOption interface {
 	applyInt64Counter(Int64CounterConfig) Int64CounterConfig
 }
 
+// BoundInt64Counter is an Int64Counter bound to a fixed attribute set. It is
+// returned by the Bind method of an Int64Counter.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundInt64Counter interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundInt64Counter
+
+	// Add records a change to the counter with the bound attributes.
+	Add(ctx context.Context, incr int64)
+}
+
 // Int64UpDownCounter is an instrument that records increasing or decreasing
 // int64 values.
 //
@@ -78,13 +109,21 @@ type Int64UpDownCounter interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Add(ctx context.Context, incr int64, options ...AddOption)
+
+	// Bind returns a BoundInt64UpDownCounter that records measurements with the
+	// attributes of attrs.
+	//
+	// Use it to record measurements with a constant attribute set, the
+	// attributes are only resolved once instead of for each measurement.
+	Bind(attrs attribute.Set) BoundInt64UpDownCounter
 }
 
 // Int64UpDownCounterConfig contains options for synchronous counter
 // instruments that record int64 values.
 type Int64UpDownCounterConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewInt64UpDownCounterConfig returns a new [Int64UpDownCounterConfig] with
@@ -107,6 +146,12 @@ func (c Int64UpDownCounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. It is nil if
+// no keys are advised, and empty if dropping all attributes is advised.
+func (c Int64UpDownCounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Int64UpDownCounterOption applies options to a [Int64UpDownCounterConfig].
 // See [InstrumentOption] for other options that can be used as an
 // Int64UpDownCounterOption.
@@ -114,6 +159,22 @@ type Int64UpDownCounterOption interface {
 	applyInt64UpDownCounter(Int64UpDownCounterConfig) Int64UpDownCounterConfig
 }
 
+// BoundInt64UpDownCounter is an Int64UpDownCounter bound to a fixed attribute
+// set. It is returned by the Bind method of an Int64UpDownCounter.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundInt64UpDownCounter interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundInt64UpDownCounter
+
+	// Add records a change to the counter with the bound attributes.
+	Add(ctx context.Context, incr int64)
+}
+
 // Int64Histogram is an instrument that records a distribution of int64
 // values.
 //
@@ -131,6 +192,13 @@ type Int64Histogram interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Record(ctx context.Context, incr int64, options ...RecordOption)
+
+	// Bind returns a BoundInt64Histogram that records measurements with the
+	// attributes of attrs.
+	//
+	// Use it to record measurements with a constant attribute set, the
+	// attributes are only resolved once instead of for each measurement.
+	Bind(attrs attribute.Set) BoundInt64Histogram
 }
 
 // Int64HistogramConfig contains options for synchronous histogram instruments
@@ -138,6 +206,7 @@ type Int64Histogram interface {
 type Int64HistogramConfig struct {
 	description              string
 	unit                     string
+	attributeKeys            []attribute.Key
 	explicitBucketBoundaries []float64
 }
 
@@ -161,6 +230,12 @@ func (c Int64HistogramConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. It is nil if
+// no keys are advised, and empty if dropping all attributes is advised.
+func (c Int64HistogramConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // ExplicitBucketBoundaries returns the configured explicit bucket boundaries.
 func (c Int64HistogramConfig) ExplicitBucketBoundaries() []float64 {
 	return c.explicitBucketBoundaries
@@ -173,6 +248,23 @@ type Int64HistogramOption interface {
 	applyInt64Histogram(Int64HistogramConfig) Int64HistogramConfig
 }
 
+// BoundInt64Histogram is an Int64Histogram bound to a fixed attribute set. It
+// is returned by the Bind method of an Int64Histogram.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundInt64Histogram interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundInt64Histogram
+
+	// Record adds an additional value to the distribution with the bound
+	// attributes.
+	Record(ctx context.Context, incr int64)
+}
+
 // Int64Gauge is an instrument that records instantaneous int64 values.
 //
 // Warning: Methods may be added to this interface in minor releases. See
@@ -189,13 +281,21 @@ type Int64Gauge interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Record(ctx context.Context, value int64, options ...RecordOption)
+
+	// Bind returns a BoundInt64Gauge that records measurements with the
+	// attributes of attrs.
+	//
+	// Use it to record measurements with a constant attribute set, the
+	// attributes are only resolved once instead of for each measurement.
+	Bind(attrs attribute.Set) BoundInt64Gauge
 }
 
 // Int64GaugeConfig contains options for synchronous gauge instruments that
 // record int64 values.
 type Int64GaugeConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewInt64GaugeConfig returns a new [Int64GaugeConfig] with all opts
@@ -218,9 +318,31 @@ func (c Int64GaugeConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. It is nil if
+// no keys are advised, and empty if dropping all attributes is advised.
+func (c Int64GaugeConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Int64GaugeOption applies options to a [Int64GaugeConfig]. See
 // [InstrumentOption] for other options that can be used as a
 // Int64GaugeOption.
 type Int64GaugeOption interface {
 	applyInt64Gauge(Int64GaugeConfig) Int64GaugeConfig
 }
+
+// BoundInt64Gauge is an Int64Gauge bound to a fixed attribute set. It is
+// returned by the Bind method of an Int64Gauge.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundInt64Gauge interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundInt64Gauge
+
+	// Record records the instantaneous value with the bound attributes.
+	Record(ctx context.Context, value int64)
+}
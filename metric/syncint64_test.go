@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func TestInt64Configuration(t *testing.T) {
@@ -15,34 +17,37 @@ func TestInt64Configuration(t *testing.T) {
 		desc         = "Instrument description."
 		uBytes       = "By"
 	)
+	keys := []attribute.Key{"user", "admin"}
 
 	run := func(got int64Config) func(*testing.T) {
 		return func(t *testing.T) {
 			assert.Equal(t, desc, got.Description(), "description")
 			assert.Equal(t, uBytes, got.Unit(), "unit")
+			assert.Equal(t, keys, got.AttributeKeys(), "attribute keys")
 		}
 	}
 
 	t.Run("Int64Counter", run(
-		NewInt64CounterConfig(WithDescription(desc), WithUnit(uBytes)),
+		NewInt64CounterConfig(WithDescription(desc), WithUnit(uBytes), WithAttributeKeys(keys...)),
 	))
 
 	t.Run("Int64UpDownCounter", run(
-		NewInt64UpDownCounterConfig(WithDescription(desc), WithUnit(uBytes)),
+		NewInt64UpDownCounterConfig(WithDescription(desc), WithUnit(uBytes), WithAttributeKeys(keys...)),
 	))
 
 	t.Run("Int64Histogram", run(
-		NewInt64HistogramConfig(WithDescription(desc), WithUnit(uBytes)),
+		NewInt64HistogramConfig(WithDescription(desc), WithUnit(uBytes), WithAttributeKeys(keys...)),
 	))
 
 	t.Run("Int64Gauge", run(
-		NewInt64GaugeConfig(WithDescription(desc), WithUnit(uBytes)),
+		NewInt64GaugeConfig(WithDescription(desc), WithUnit(uBytes), WithAttributeKeys(keys...)),
 	))
 }
 
 type int64Config interface {
 	Description() string
 	Unit() string
+	AttributeKeys() []attribute.Key
 }
 
 func TestInt64ExplicitBucketHistogramConfiguration(t *testing.T) {
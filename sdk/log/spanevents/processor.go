@@ -0,0 +1,237 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package spanevents provides a trace SpanProcessor that emits the events of
+// spans, including the exceptions recorded with RecordError, as log records.
+//
+// This lets the events be searched in a logging back-end along with the other
+// log records of the application:
+//
+//	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(
+//		spanevents.NewSpanProcessor(loggerProvider, sdktrace.NewBatchSpanProcessor(exporter),
+//			spanevents.WithStripEvents(),
+//		),
+//	))
+package spanevents // import "go.opentelemetry.io/otel/sdk/log/spanevents"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Option configures a SpanProcessor.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(c config) config {
+	return fn(c)
+}
+
+type config struct {
+	stripEvents bool
+}
+
+// WithStripEvents returns an Option that removes the events from the spans
+// passed to the next SpanProcessor, so they are not exported both as span
+// events and as log records. The number of dropped events of the spans is
+// left unchanged.
+func WithStripEvents() Option {
+	return optionFunc(func(c config) config {
+		c.stripEvents = true
+		return c
+	})
+}
+
+// SpanProcessor is a SpanProcessor that emits the events of the ended spans
+// as log records and passes the spans to another SpanProcessor.
+//
+// The log records of a span are emitted with a Logger of the instrumentation
+// scope of the span, in the context of the span so they carry its trace and
+// span IDs. Each log record has:
+//   - the timestamp of the event, and the time the span ended as its
+//     observed timestamp.
+//   - the name of the event as its body and as the event.name attribute.
+//   - the attributes of the event.
+//   - the ERROR severity for exception events, INFO otherwise.
+type SpanProcessor struct {
+	lp   log.LoggerProvider
+	next sdktrace.SpanProcessor
+	cfg  config
+
+	mu      sync.Mutex
+	loggers map[instrumentation.Scope]log.Logger
+}
+
+var _ sdktrace.SpanProcessor = (*SpanProcessor)(nil)
+
+// NewSpanProcessor returns a SpanProcessor that emits the events of the ended
+// spans with the Loggers of lp and passes the spans to next. If next is nil,
+// the events are only emitted as log records.
+func NewSpanProcessor(lp log.LoggerProvider, next sdktrace.SpanProcessor, opts ...Option) *SpanProcessor {
+	var cfg config
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return &SpanProcessor{
+		lp:      lp,
+		next:    next,
+		cfg:     cfg,
+		loggers: make(map[instrumentation.Scope]log.Logger),
+	}
+}
+
+// OnStart passes s to the next SpanProcessor.
+func (p *SpanProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	if p.next != nil {
+		p.next.OnStart(parent, s)
+	}
+}
+
+// OnEnd emits the events of s as log records and passes s to the next
+// SpanProcessor.
+func (p *SpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	events := s.Events()
+	if len(events) > 0 {
+		p.emit(s, events)
+	}
+
+	if p.next == nil {
+		return
+	}
+	if p.cfg.stripEvents && len(events) > 0 {
+		s = strippedSpan{ReadOnlySpan: s}
+	}
+	p.next.OnEnd(s)
+}
+
+func (p *SpanProcessor) emit(s sdktrace.ReadOnlySpan, events []sdktrace.Event) {
+	logger := p.logger(s.InstrumentationScope())
+	ctx := trace.ContextWithSpanContext(context.Background(), s.SpanContext())
+	observed := s.EndTime()
+	if observed.IsZero() {
+		observed = time.Now()
+	}
+
+	for _, e := range events {
+		var r log.Record
+		r.SetTimestamp(e.Time)
+		r.SetObservedTimestamp(observed)
+		if e.Name == semconv.ExceptionEventName {
+			r.SetSeverity(log.SeverityError)
+			r.SetSeverityText("ERROR")
+		} else {
+			r.SetSeverity(log.SeverityInfo)
+			r.SetSeverityText("INFO")
+		}
+		r.SetBody(log.StringValue(e.Name))
+
+		attrs := make([]log.KeyValue, 0, len(e.Attributes)+1)
+		attrs = append(attrs, log.String(string(semconv.EventNameKey), e.Name))
+		for _, a := range e.Attributes {
+			attrs = append(attrs, convertAttr(a))
+		}
+		r.AddAttributes(attrs...)
+
+		logger.Emit(ctx, r)
+	}
+}
+
+// logger returns the Logger of the instrumentation scope.
+func (p *SpanProcessor) logger(scope instrumentation.Scope) log.Logger {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	l, ok := p.loggers[scope]
+	if !ok {
+		l = p.lp.Logger(scope.Name,
+			log.WithInstrumentationVersion(scope.Version),
+			log.WithSchemaURL(scope.SchemaURL),
+		)
+		p.loggers[scope] = l
+	}
+	return l
+}
+
+// Shutdown shuts down the next SpanProcessor. The LoggerProvider is not shut
+// down.
+func (p *SpanProcessor) Shutdown(ctx context.Context) error {
+	if p.next == nil {
+		return nil
+	}
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the next SpanProcessor. The LoggerProvider is not
+// flushed.
+func (p *SpanProcessor) ForceFlush(ctx context.Context) error {
+	if p.next == nil {
+		return nil
+	}
+	return p.next.ForceFlush(ctx)
+}
+
+// strippedSpan is a span without its events.
+type strippedSpan struct {
+	sdktrace.ReadOnlySpan
+}
+
+// Events returns no events.
+func (strippedSpan) Events() []sdktrace.Event { return nil }
+
+// convertAttr returns the log attribute of a.
+func convertAttr(a attribute.KeyValue) log.KeyValue {
+	return log.KeyValue{Key: string(a.Key), Value: convertValue(a.Value)}
+}
+
+func convertValue(v attribute.Value) log.Value {
+	switch v.Type() {
+	case attribute.BOOL:
+		return log.BoolValue(v.AsBool())
+	case attribute.INT64:
+		return log.Int64Value(v.AsInt64())
+	case attribute.FLOAT64:
+		return log.Float64Value(v.AsFloat64())
+	case attribute.STRING:
+		return log.StringValue(v.AsString())
+	case attribute.BOOLSLICE:
+		bs := v.AsBoolSlice()
+		vs := make([]log.Value, len(bs))
+		for i, b := range bs {
+			vs[i] = log.BoolValue(b)
+		}
+		return log.SliceValue(vs...)
+	case attribute.INT64SLICE:
+		is := v.AsInt64Slice()
+		vs := make([]log.Value, len(is))
+		for i, n := range is {
+			vs[i] = log.Int64Value(n)
+		}
+		return log.SliceValue(vs...)
+	case attribute.FLOAT64SLICE:
+		fs := v.AsFloat64Slice()
+		vs := make([]log.Value, len(fs))
+		for i, f := range fs {
+			vs[i] = log.Float64Value(f)
+		}
+		return log.SliceValue(vs...)
+	case attribute.STRINGSLICE:
+		ss := v.AsStringSlice()
+		vs := make([]log.Value, len(ss))
+		for i, s := range ss {
+			vs[i] = log.StringValue(s)
+		}
+		return log.SliceValue(vs...)
+	default:
+		return log.Value{}
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanevents
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordingProcessor is a log Processor recording the emitted records.
+type recordingProcessor struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+func (p *recordingProcessor) OnEmit(_ context.Context, r sdklog.Record) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.records = append(p.records, r.Clone())
+	return nil
+}
+
+func (p *recordingProcessor) Enabled(context.Context, sdklog.Record) bool { return true }
+func (p *recordingProcessor) Shutdown(context.Context) error              { return nil }
+func (p *recordingProcessor) ForceFlush(context.Context) error            { return nil }
+
+func (p *recordingProcessor) Records() []sdklog.Record {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]sdklog.Record(nil), p.records...)
+}
+
+func attrs(r sdklog.Record) map[string]log.Value {
+	out := make(map[string]log.Value)
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		out[kv.Key] = kv.Value
+		return true
+	})
+	return out
+}
+
+func TestSpanProcessor(t *testing.T) {
+	rec := new(recordingProcessor)
+	lp := sdklog.NewLoggerProvider(sdklog.WithProcessor(rec))
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(
+		NewSpanProcessor(lp, sdktrace.NewSimpleSpanProcessor(exp)),
+	))
+	tr := tp.Tracer("tracer", trace.WithInstrumentationVersion("v1"))
+
+	eventTime := time.Unix(100, 0)
+	_, span := tr.Start(context.Background(), "span")
+	span.AddEvent("cache miss", trace.WithTimestamp(eventTime), trace.WithAttributes(
+		attribute.String("key", "value"),
+		attribute.Int64Slice("ints", []int64{1, 2}),
+	))
+	span.RecordError(errors.New("failure"))
+	span.End()
+
+	records := rec.Records()
+	require.Len(t, records, 2)
+
+	r := records[0]
+	assert.Equal(t, eventTime, r.Timestamp())
+	assert.False(t, r.ObservedTimestamp().IsZero())
+	assert.Equal(t, log.SeverityInfo, r.Severity())
+	assert.Equal(t, "INFO", r.SeverityText())
+	assert.Equal(t, log.StringValue("cache miss"), r.Body())
+	assert.Equal(t, span.SpanContext().TraceID(), r.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), r.SpanID())
+	assert.Equal(t, "tracer", r.InstrumentationScope().Name)
+	assert.Equal(t, "v1", r.InstrumentationScope().Version)
+	assert.Equal(t, map[string]log.Value{
+		"event.name": log.StringValue("cache miss"),
+		"key":        log.StringValue("value"),
+		"ints":       log.SliceValue(log.Int64Value(1), log.Int64Value(2)),
+	}, attrs(r))
+
+	r = records[1]
+	assert.Equal(t, log.SeverityError, r.Severity())
+	assert.Equal(t, log.StringValue("exception"), r.Body())
+	assert.Equal(t, log.StringValue("failure"), attrs(r)["exception.message"])
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Len(t, spans[0].Events, 2, "events should be kept by default")
+}
+
+func TestSpanProcessorStripEvents(t *testing.T) {
+	rec := new(recordingProcessor)
+	lp := sdklog.NewLoggerProvider(sdklog.WithProcessor(rec))
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(
+		NewSpanProcessor(lp, sdktrace.NewSimpleSpanProcessor(exp), WithStripEvents()),
+	))
+
+	_, span := tp.Tracer("tracer").Start(context.Background(), "span")
+	span.AddEvent("event")
+	span.End()
+
+	assert.Len(t, rec.Records(), 1)
+	spans := exp.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Empty(t, spans[0].Events)
+	assert.Equal(t, "span", spans[0].Name)
+}
+
+func TestSpanProcessorNilNext(t *testing.T) {
+	rec := new(recordingProcessor)
+	p := NewSpanProcessor(sdklog.NewLoggerProvider(sdklog.WithProcessor(rec)), nil)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(p))
+
+	_, span := tp.Tracer("tracer").Start(context.Background(), "span")
+	span.AddEvent("event")
+	span.End()
+
+	assert.Len(t, rec.Records(), 1)
+	assert.NoError(t, p.ForceFlush(context.Background()))
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestConvertValue(t *testing.T) {
+	tests := []struct {
+		in   attribute.Value
+		want log.Value
+	}{
+		{attribute.BoolValue(true), log.BoolValue(true)},
+		{attribute.Int64Value(1), log.Int64Value(1)},
+		{attribute.Float64Value(1.5), log.Float64Value(1.5)},
+		{attribute.StringValue("s"), log.StringValue("s")},
+		{attribute.BoolSliceValue([]bool{true}), log.SliceValue(log.BoolValue(true))},
+		{attribute.Int64SliceValue([]int64{1}), log.SliceValue(log.Int64Value(1))},
+		{attribute.Float64SliceValue([]float64{1.5}), log.SliceValue(log.Float64Value(1.5))},
+		{attribute.StringSliceValue([]string{"s"}), log.SliceValue(log.StringValue("s"))},
+		{attribute.Value{}, log.Value{}},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, convertValue(test.in), test.in.Type().String())
+	}
+}
@@ -59,6 +59,12 @@ func benchSyncViews(views ...View) func(*testing.B) {
 				return func() { iCtr.Add(ctx, 1, o...) }
 			}
 		}()))
+		b.Run("Int64Counter/Bound", benchMeasAttrs(func() measF {
+			return func(s attribute.Set) func() {
+				bound := iCtr.Bind(s)
+				return func() { bound.Add(ctx, 1) }
+			}
+		}()))
 
 		fCtr, err := meter.Float64Counter("float64-counter")
 		assert.NoError(b, err)
@@ -68,6 +74,12 @@ func benchSyncViews(views ...View) func(*testing.B) {
 				return func() { fCtr.Add(ctx, 1, o...) }
 			}
 		}()))
+		b.Run("Float64Counter/Bound", benchMeasAttrs(func() measF {
+			return func(s attribute.Set) func() {
+				bound := fCtr.Bind(s)
+				return func() { bound.Add(ctx, 1) }
+			}
+		}()))
 
 		iUDCtr, err := meter.Int64UpDownCounter("int64-up-down-counter")
 		assert.NoError(b, err)
@@ -77,6 +89,12 @@ func benchSyncViews(views ...View) func(*testing.B) {
 				return func() { iUDCtr.Add(ctx, 1, o...) }
 			}
 		}()))
+		b.Run("Int64UpDownCounter/Bound", benchMeasAttrs(func() measF {
+			return func(s attribute.Set) func() {
+				bound := iUDCtr.Bind(s)
+				return func() { bound.Add(ctx, 1) }
+			}
+		}()))
 
 		fUDCtr, err := meter.Float64UpDownCounter("float64-up-down-counter")
 		assert.NoError(b, err)
@@ -86,6 +104,12 @@ func benchSyncViews(views ...View) func(*testing.B) {
 				return func() { fUDCtr.Add(ctx, 1, o...) }
 			}
 		}()))
+		b.Run("Float64UpDownCounter/Bound", benchMeasAttrs(func() measF {
+			return func(s attribute.Set) func() {
+				bound := fUDCtr.Bind(s)
+				return func() { bound.Add(ctx, 1) }
+			}
+		}()))
 
 		iHist, err := meter.Int64Histogram("int64-histogram")
 		assert.NoError(b, err)
@@ -95,6 +119,12 @@ func benchSyncViews(views ...View) func(*testing.B) {
 				return func() { iHist.Record(ctx, 1, o...) }
 			}
 		}()))
+		b.Run("Int64Histogram/Bound", benchMeasAttrs(func() measF {
+			return func(s attribute.Set) func() {
+				bound := iHist.Bind(s)
+				return func() { bound.Record(ctx, 1) }
+			}
+		}()))
 
 		fHist, err := meter.Float64Histogram("float64-histogram")
 		assert.NoError(b, err)
@@ -104,6 +134,12 @@ func benchSyncViews(views ...View) func(*testing.B) {
 				return func() { fHist.Record(ctx, 1, o...) }
 			}
 		}()))
+		b.Run("Float64Histogram/Bound", benchMeasAttrs(func() measF {
+			return func(s attribute.Set) func() {
+				bound := fHist.Bind(s)
+				return func() { bound.Record(ctx, 1) }
+			}
+		}()))
 	}
 }
 
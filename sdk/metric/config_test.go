@@ -20,6 +20,8 @@ type reader struct {
 	externalProducers []Producer
 	temporalityFunc   TemporalitySelector
 	aggregationFunc   AggregationSelector
+	cardLimit         int
+	eviction          EvictionPolicy
 	collectFunc       func(context.Context, *metricdata.ResourceMetrics) error
 	forceFlushFunc    func(context.Context) error
 	shutdownFunc      func(context.Context) error
@@ -31,6 +33,9 @@ func (r *reader) aggregation(kind InstrumentKind) Aggregation { // nolint:revive
 	return r.aggregationFunc(kind)
 }
 
+func (r *reader) cardinalityLimit() int          { return r.cardLimit }
+func (r *reader) evictionPolicy() EvictionPolicy { return r.eviction }
+
 func (r *reader) register(p sdkProducer)      { r.producer = p }
 func (r *reader) RegisterProducer(p Producer) { r.externalProducers = append(r.externalProducers, p) }
 func (r *reader) temporality(kind InstrumentKind) metricdata.Temporality {
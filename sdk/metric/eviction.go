@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+)
+
+// EvictionPolicy defines when the stale attribute sets of a stream with
+// cumulative temporality are evicted.
+//
+// By default, a cumulative aggregation keeps the data-point of every
+// attribute set ever measured. A stream measured with attribute values that
+// are constantly renewed (e.g. user IDs, short-lived job names) then uses an
+// unbounded amount of memory. With an EvictionPolicy, the data-points that are
+// no longer measured are dropped. If an evicted attribute set is measured
+// again, its aggregation restarts from zero with a new start time.
+//
+// Streams with delta temporality, and the streams of asynchronous counters,
+// only report the attribute sets measured during the last collection cycle
+// and are not affected.
+type EvictionPolicy struct {
+	// MaxIdleCycles is the number of consecutive collection cycles without
+	// measurement after which an attribute set is evicted. If it is less
+	// than or equal to zero, attribute sets are not evicted based on the
+	// number of collection cycles.
+	MaxIdleCycles int
+	// MaxIdleTime is the time without measurement after which an attribute
+	// set is evicted. It is checked when the stream is collected. If it is
+	// less than or equal to zero, attribute sets are not evicted based on
+	// time.
+	MaxIdleTime time.Duration
+	// OnEvict, if not nil, is called with the name of the stream and the
+	// attributes of each evicted data-point. It is called during the
+	// collection and must not block nor record measurements.
+	OnEvict func(stream string, attrs attribute.Set)
+}
+
+// enabled returns if p evicts stale attribute sets.
+func (p EvictionPolicy) enabled() bool {
+	return p.MaxIdleCycles > 0 || p.MaxIdleTime > 0
+}
+
+// eviction returns the aggregate eviction policy of the stream named name.
+// The evictions are logged at the debug level.
+func (p EvictionPolicy) eviction(name string) aggregate.Eviction {
+	if !p.enabled() {
+		return aggregate.Eviction{}
+	}
+	return aggregate.Eviction{
+		MaxIdleCycles: p.MaxIdleCycles,
+		MaxIdleTime:   p.MaxIdleTime,
+		OnEvict: func(attrs attribute.Set) {
+			global.Debug("evicted stale metric stream data-point", "name", name, "attributes", attrs)
+			if p.OnEvict != nil {
+				p.OnEvict(name, attrs)
+			}
+		},
+	}
+}
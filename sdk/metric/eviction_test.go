@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestEvictionPolicy(t *testing.T) {
+	type evicted struct {
+		stream string
+		attrs  attribute.Set
+	}
+
+	setup := func(t *testing.T, opts []ManualReaderOption, views ...View) (metric.Int64Counter, metric.Int64Histogram, func() map[string]map[string]int64, *[]evicted) {
+		t.Helper()
+
+		var got []evicted
+		onEvict := func(stream string, attrs attribute.Set) {
+			got = append(got, evicted{stream: stream, attrs: attrs})
+		}
+		opts = append(opts, WithEvictionPolicy(EvictionPolicy{MaxIdleCycles: 2, OnEvict: onEvict}))
+
+		r := NewManualReader(opts...)
+		m := NewMeterProvider(WithReader(r), WithView(views...)).Meter("TestEvictionPolicy")
+
+		counter, err := m.Int64Counter("jobs")
+		require.NoError(t, err)
+		hist, err := m.Int64Histogram("duration")
+		require.NoError(t, err)
+
+		// collect returns the value of each data-point of each stream, by
+		// the value of its "job" attribute.
+		collect := func() map[string]map[string]int64 {
+			rm := new(metricdata.ResourceMetrics)
+			require.NoError(t, r.Collect(context.Background(), rm))
+
+			out := make(map[string]map[string]int64)
+			for _, sm := range rm.ScopeMetrics {
+				for _, m := range sm.Metrics {
+					values := make(map[string]int64)
+					switch data := m.Data.(type) {
+					case metricdata.Sum[int64]:
+						for _, dPt := range data.DataPoints {
+							job, _ := dPt.Attributes.Value("job")
+							values[job.AsString()] = dPt.Value
+						}
+					case metricdata.Histogram[int64]:
+						for _, dPt := range data.DataPoints {
+							job, _ := dPt.Attributes.Value("job")
+							values[job.AsString()] = int64(dPt.Count)
+						}
+					}
+					out[m.Name] = values
+				}
+			}
+			return out
+		}
+		return counter, hist, collect, &got
+	}
+
+	ctx := context.Background()
+	jobA := metric.WithAttributes(attribute.String("job", "a"))
+	jobB := metric.WithAttributes(attribute.String("job", "b"))
+
+	t.Run("Reader", func(t *testing.T) {
+		counter, hist, collect, evictions := setup(t, nil)
+
+		counter.Add(ctx, 1, jobA)
+		counter.Add(ctx, 1, jobB)
+		hist.Record(ctx, 1, jobA)
+		assert.Equal(t, map[string]map[string]int64{
+			"jobs":     {"a": 1, "b": 1},
+			"duration": {"a": 1},
+		}, collect())
+
+		counter.Add(ctx, 1, jobA)
+		assert.Equal(t, map[string]map[string]int64{
+			"jobs":     {"a": 2, "b": 1},
+			"duration": {"a": 1},
+		}, collect())
+		assert.Empty(t, *evictions)
+
+		counter.Add(ctx, 1, jobA)
+		assert.Equal(t, map[string]map[string]int64{
+			"jobs": {"a": 3},
+		}, collect())
+		assert.ElementsMatch(t, []evicted{
+			{stream: "jobs", attrs: attribute.NewSet(attribute.String("job", "b"))},
+			{stream: "duration", attrs: attribute.NewSet(attribute.String("job", "a"))},
+		}, *evictions)
+
+		// Evicted streams restart from zero.
+		counter.Add(ctx, 5, jobB)
+		assert.Equal(t, map[string]map[string]int64{
+			"jobs": {"a": 3, "b": 5},
+		}, collect())
+	})
+
+	t.Run("View", func(t *testing.T) {
+		view := NewView(Instrument{Name: "jobs"}, Stream{
+			EvictionPolicy: EvictionPolicy{MaxIdleCycles: 1},
+		})
+		counter, hist, collect, evictions := setup(t, nil, view)
+
+		counter.Add(ctx, 1, jobA)
+		hist.Record(ctx, 1, jobA)
+		assert.Equal(t, map[string]map[string]int64{
+			"jobs":     {"a": 1},
+			"duration": {"a": 1},
+		}, collect())
+
+		assert.Equal(t, map[string]map[string]int64{
+			"duration": {"a": 1},
+		}, collect(), "view policy takes precedence")
+		assert.Empty(t, *evictions, "OnEvict of the reader policy called")
+	})
+
+	t.Run("Delta", func(t *testing.T) {
+		deltaSelector := func(InstrumentKind) metricdata.Temporality {
+			return metricdata.DeltaTemporality
+		}
+		counter, _, collect, evictions := setup(t, []ManualReaderOption{
+			WithTemporalitySelector(deltaSelector),
+		})
+
+		counter.Add(ctx, 1, jobA)
+		assert.Equal(t, map[string]int64{"a": 1}, collect()["jobs"])
+		for i := 0; i < 3; i++ {
+			assert.Empty(t, collect()["jobs"])
+		}
+		assert.Empty(t, *evictions)
+	})
+}
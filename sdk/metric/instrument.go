@@ -70,6 +70,13 @@ type Instrument struct {
 	// Scope identifies the instrumentation that created the instrument.
 	Scope instrumentation.Scope
 
+	// scopeAttributes are the attributes of the instrumentation scope. They
+	// are only used to match views created with a Selector.
+	scopeAttributes attribute.Set
+	// attributeKeys are the advisory attribute keys of the instrument. They
+	// are not used to match views.
+	attributeKeys []attribute.Key
+
 	// Ensure forward compatibility if non-comparable fields need to be added.
 	nonComparable // nolint: unused
 }
@@ -143,7 +150,26 @@ type Stream struct {
 	//
 	// Use NewAllowKeysFilter from "go.opentelemetry.io/otel/attribute" to
 	// provide an allow-list of attribute keys here.
+	//
+	// If AttributeFilter is nil and the instrument was created with advisory
+	// attribute keys (see WithAttributeKeys from
+	// "go.opentelemetry.io/otel/metric"), only the attributes with those keys
+	// are recorded.
 	AttributeFilter attribute.Filter
+	// CardinalityLimit is the maximum number of distinct attribute sets
+	// aggregated for the stream. Measurements made with new attribute sets
+	// once the limit is reached are aggregated into a single data-point with
+	// the "otel.metric.overflow" attribute.
+	//
+	// If CardinalityLimit is zero, the default cardinality limit of the
+	// Reader is used. If it is less than zero, the stream has no limit.
+	CardinalityLimit int
+	// EvictionPolicy is the policy used to evict the stale attribute sets of
+	// the stream if it has cumulative temporality.
+	//
+	// If the policy does not evict attribute sets, the default eviction
+	// policy of the Reader is used.
+	EvictionPolicy EvictionPolicy
 }
 
 // instID are the identifying properties of a instrument.
@@ -172,20 +198,9 @@ func (i instID) normalize() instID {
 
 type int64Inst struct {
 	measures []aggregate.Measure[int64]
-
-	embedded.Int64Counter
-	embedded.Int64UpDownCounter
-	embedded.Int64Histogram
-	embedded.Int64Gauge
+	binds    []aggregate.Bind[int64]
 }
 
-var (
-	_ metric.Int64Counter       = (*int64Inst)(nil)
-	_ metric.Int64UpDownCounter = (*int64Inst)(nil)
-	_ metric.Int64Histogram     = (*int64Inst)(nil)
-	_ metric.Int64Gauge         = (*int64Inst)(nil)
-)
-
 func (i *int64Inst) Add(ctx context.Context, val int64, opts ...metric.AddOption) {
 	c := metric.NewAddConfig(opts)
 	i.aggregate(ctx, val, c.Attributes())
@@ -202,22 +217,99 @@ func (i *int64Inst) aggregate(ctx context.Context, val int64, s attribute.Set) {
 	}
 }
 
-type float64Inst struct {
-	measures []aggregate.Measure[float64]
+// bind returns the measurements of i bound to attrs.
+func (i *int64Inst) bind(attrs attribute.Set) *int64Bound {
+	b := &int64Bound{measures: make([]aggregate.BoundMeasure[int64], len(i.binds))}
+	for j, bind := range i.binds {
+		b.measures[j] = bind(attrs)
+	}
+	return b
+}
 
-	embedded.Float64Counter
-	embedded.Float64UpDownCounter
-	embedded.Float64Histogram
-	embedded.Float64Gauge
+// int64Counter is the int64 counter returned to users.
+type int64Counter struct {
+	*int64Inst
+	embedded.Int64Counter
+}
+
+var _ metric.Int64Counter = int64Counter{}
+
+func (i int64Counter) Bind(attrs attribute.Set) metric.BoundInt64Counter {
+	return i.bind(attrs)
+}
+
+// int64UpDownCounter is the int64 upDownCounter returned to users.
+type int64UpDownCounter struct {
+	*int64Inst
+	embedded.Int64UpDownCounter
+}
+
+var _ metric.Int64UpDownCounter = int64UpDownCounter{}
+
+func (i int64UpDownCounter) Bind(attrs attribute.Set) metric.BoundInt64UpDownCounter {
+	return i.bind(attrs)
+}
+
+// int64Histogram is the int64 histogram returned to users.
+type int64Histogram struct {
+	*int64Inst
+	embedded.Int64Histogram
+}
+
+var _ metric.Int64Histogram = int64Histogram{}
+
+func (i int64Histogram) Bind(attrs attribute.Set) metric.BoundInt64Histogram {
+	return i.bind(attrs)
+}
+
+// int64Gauge is the int64 gauge returned to users.
+type int64Gauge struct {
+	*int64Inst
+	embedded.Int64Gauge
+}
+
+var _ metric.Int64Gauge = int64Gauge{}
+
+func (i int64Gauge) Bind(attrs attribute.Set) metric.BoundInt64Gauge {
+	return i.bind(attrs)
+}
+
+// int64Bound is an int64 instrument bound to an attribute set.
+type int64Bound struct {
+	measures []aggregate.BoundMeasure[int64]
+
+	embedded.BoundInt64Counter
+	embedded.BoundInt64UpDownCounter
+	embedded.BoundInt64Histogram
+	embedded.BoundInt64Gauge
 }
 
 var (
-	_ metric.Float64Counter       = (*float64Inst)(nil)
-	_ metric.Float64UpDownCounter = (*float64Inst)(nil)
-	_ metric.Float64Histogram     = (*float64Inst)(nil)
-	_ metric.Float64Gauge         = (*float64Inst)(nil)
+	_ metric.BoundInt64Counter       = (*int64Bound)(nil)
+	_ metric.BoundInt64UpDownCounter = (*int64Bound)(nil)
+	_ metric.BoundInt64Histogram     = (*int64Bound)(nil)
+	_ metric.BoundInt64Gauge         = (*int64Bound)(nil)
 )
 
+func (b *int64Bound) Add(ctx context.Context, val int64) {
+	b.aggregate(ctx, val)
+}
+
+func (b *int64Bound) Record(ctx context.Context, val int64) {
+	b.aggregate(ctx, val)
+}
+
+func (b *int64Bound) aggregate(ctx context.Context, val int64) { // nolint:revive  // okay to shadow pkg with method.
+	for _, in := range b.measures {
+		in(ctx, val)
+	}
+}
+
+type float64Inst struct {
+	measures []aggregate.Measure[float64]
+	binds    []aggregate.Bind[float64]
+}
+
 func (i *float64Inst) Add(ctx context.Context, val float64, opts ...metric.AddOption) {
 	c := metric.NewAddConfig(opts)
 	i.aggregate(ctx, val, c.Attributes())
@@ -234,6 +326,94 @@ func (i *float64Inst) aggregate(ctx context.Context, val float64, s attribute.Se
 	}
 }
 
+// bind returns the measurements of i bound to attrs.
+func (i *float64Inst) bind(attrs attribute.Set) *float64Bound {
+	b := &float64Bound{measures: make([]aggregate.BoundMeasure[float64], len(i.binds))}
+	for j, bind := range i.binds {
+		b.measures[j] = bind(attrs)
+	}
+	return b
+}
+
+// float64Counter is the float64 counter returned to users.
+type float64Counter struct {
+	*float64Inst
+	embedded.Float64Counter
+}
+
+var _ metric.Float64Counter = float64Counter{}
+
+func (i float64Counter) Bind(attrs attribute.Set) metric.BoundFloat64Counter {
+	return i.bind(attrs)
+}
+
+// float64UpDownCounter is the float64 upDownCounter returned to users.
+type float64UpDownCounter struct {
+	*float64Inst
+	embedded.Float64UpDownCounter
+}
+
+var _ metric.Float64UpDownCounter = float64UpDownCounter{}
+
+func (i float64UpDownCounter) Bind(attrs attribute.Set) metric.BoundFloat64UpDownCounter {
+	return i.bind(attrs)
+}
+
+// float64Histogram is the float64 histogram returned to users.
+type float64Histogram struct {
+	*float64Inst
+	embedded.Float64Histogram
+}
+
+var _ metric.Float64Histogram = float64Histogram{}
+
+func (i float64Histogram) Bind(attrs attribute.Set) metric.BoundFloat64Histogram {
+	return i.bind(attrs)
+}
+
+// float64Gauge is the float64 gauge returned to users.
+type float64Gauge struct {
+	*float64Inst
+	embedded.Float64Gauge
+}
+
+var _ metric.Float64Gauge = float64Gauge{}
+
+func (i float64Gauge) Bind(attrs attribute.Set) metric.BoundFloat64Gauge {
+	return i.bind(attrs)
+}
+
+// float64Bound is an float64 instrument bound to an attribute set.
+type float64Bound struct {
+	measures []aggregate.BoundMeasure[float64]
+
+	embedded.BoundFloat64Counter
+	embedded.BoundFloat64UpDownCounter
+	embedded.BoundFloat64Histogram
+	embedded.BoundFloat64Gauge
+}
+
+var (
+	_ metric.BoundFloat64Counter       = (*float64Bound)(nil)
+	_ metric.BoundFloat64UpDownCounter = (*float64Bound)(nil)
+	_ metric.BoundFloat64Histogram     = (*float64Bound)(nil)
+	_ metric.BoundFloat64Gauge         = (*float64Bound)(nil)
+)
+
+func (b *float64Bound) Add(ctx context.Context, val float64) {
+	b.aggregate(ctx, val)
+}
+
+func (b *float64Bound) Record(ctx context.Context, val float64) {
+	b.aggregate(ctx, val)
+}
+
+func (b *float64Bound) aggregate(ctx context.Context, val float64) {
+	for _, in := range b.measures {
+		in(ctx, val)
+	}
+}
+
 // observablID is a comparable unique identifier of an observable.
 type observablID[N int64 | float64] struct {
 	name        string
@@ -21,27 +21,34 @@ func BenchmarkInstrument(b *testing.B) {
 		)
 	}
 
-	b.Run("instrumentImpl/aggregate", func(b *testing.B) {
+	newInst := func() *int64Inst {
 		build := aggregate.Builder[int64]{}
-		var meas []aggregate.Measure[int64]
+		var (
+			meas  []aggregate.Measure[int64]
+			binds []aggregate.Bind[int64]
+		)
 
 		build.Temporality = metricdata.CumulativeTemporality
-		in, _ := build.LastValue()
-		meas = append(meas, in)
+		in, _, bind := build.LastValue()
+		meas, binds = append(meas, in), append(binds, bind)
 
 		build.Temporality = metricdata.DeltaTemporality
-		in, _ = build.LastValue()
-		meas = append(meas, in)
+		in, _, bind = build.LastValue()
+		meas, binds = append(meas, in), append(binds, bind)
 
 		build.Temporality = metricdata.CumulativeTemporality
-		in, _ = build.Sum(true)
-		meas = append(meas, in)
+		in, _, bind = build.Sum(true)
+		meas, binds = append(meas, in), append(binds, bind)
 
 		build.Temporality = metricdata.DeltaTemporality
-		in, _ = build.Sum(true)
-		meas = append(meas, in)
+		in, _, bind = build.Sum(true)
+		meas, binds = append(meas, in), append(binds, bind)
 
-		inst := int64Inst{measures: meas}
+		return &int64Inst{measures: meas, binds: binds}
+	}
+
+	b.Run("instrumentImpl/aggregate", func(b *testing.B) {
+		inst := newInst()
 		ctx := context.Background()
 
 		b.ReportAllocs()
@@ -51,19 +58,42 @@ func BenchmarkInstrument(b *testing.B) {
 		}
 	})
 
+	b.Run("instrumentImpl/aggregate/fixed", func(b *testing.B) {
+		inst := newInst()
+		ctx := context.Background()
+		s := attr(0)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			inst.aggregate(ctx, int64(i), s)
+		}
+	})
+
+	b.Run("boundImpl/aggregate", func(b *testing.B) {
+		bound := newInst().bind(attr(0))
+		ctx := context.Background()
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			bound.aggregate(ctx, int64(i))
+		}
+	})
+
 	b.Run("observable/observe", func(b *testing.B) {
 		build := aggregate.Builder[int64]{}
 		var meas []aggregate.Measure[int64]
 
-		in, _ := build.PrecomputedLastValue()
+		in, _, _ := build.PrecomputedLastValue()
 		meas = append(meas, in)
 
 		build.Temporality = metricdata.CumulativeTemporality
-		in, _ = build.Sum(true)
+		in, _, _ = build.Sum(true)
 		meas = append(meas, in)
 
 		build.Temporality = metricdata.DeltaTemporality
-		in, _ = build.Sum(true)
+		in, _, _ = build.Sum(true)
 		meas = append(meas, in)
 
 		o := observable[int64]{measures: meas}
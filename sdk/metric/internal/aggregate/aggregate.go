@@ -19,6 +19,15 @@ var now = time.Now
 // Measure receives measurements to be aggregated.
 type Measure[N int64 | float64] func(context.Context, N, attribute.Set)
 
+// BoundMeasure receives measurements to be aggregated for the attribute set it
+// is bound to.
+type BoundMeasure[N int64 | float64] func(context.Context, N)
+
+// Bind returns a BoundMeasure for an attribute set. The attribute set is
+// filtered once when bound, and the aggregate it is measured into is cached
+// by the returned BoundMeasure.
+type Bind[N int64 | float64] func(attribute.Set) BoundMeasure[N]
+
 // ComputeAggregation stores the aggregate of measurements into dest and
 // returns the number of aggregate data-points output.
 type ComputeAggregation func(dest *metricdata.Aggregation) int
@@ -48,6 +57,14 @@ type Builder[N int64 | float64] struct {
 	// If AggregationLimit is less than or equal to zero there will not be an
 	// aggregation limit imposed (i.e. unlimited attribute sets).
 	AggregationLimit int
+	// Eviction is the policy used to evict the attribute sets that are no
+	// longer measured from the cumulative aggregate functions. It is not
+	// used for other temporalities, nor for precomputed aggregate functions
+	// that only report the attribute sets measured during the last
+	// collection cycle.
+	//
+	// If this is not provided, attribute sets are never evicted.
+	Eviction Eviction
 }
 
 func (b Builder[N]) resFunc() func() exemplar.Reservoir {
@@ -73,74 +90,96 @@ func (b Builder[N]) filter(f fltrMeasure[N]) Measure[N] {
 	}
 }
 
-// LastValue returns a last-value aggregate function input and output.
-func (b Builder[N]) LastValue() (Measure[N], ComputeAggregation) {
+type fltrBind[N int64 | float64] func(fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) BoundMeasure[N]
+
+func (b Builder[N]) bind(f fltrBind[N]) Bind[N] {
+	if b.Filter != nil {
+		fltr := b.Filter // Copy to make it immutable after assignment.
+		return func(a attribute.Set) BoundMeasure[N] {
+			fAttr, dropped := a.Filter(fltr)
+			return f(fAttr, dropped)
+		}
+	}
+	return func(a attribute.Set) BoundMeasure[N] {
+		return f(a, nil)
+	}
+}
+
+// LastValue returns a last-value aggregate function input, output, and input
+// binder.
+func (b Builder[N]) LastValue() (Measure[N], ComputeAggregation, Bind[N]) {
 	lv := newLastValue[N](b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(lv.measure), lv.delta
+		return b.filter(lv.measure), lv.delta, b.bind(lv.bind)
 	default:
-		return b.filter(lv.measure), lv.cumulative
+		lv.evict = newEvictor(b.Eviction, lv.start)
+		return b.filter(lv.measure), lv.cumulative, b.bind(lv.bind)
 	}
 }
 
 // PrecomputedLastValue returns a last-value aggregate function input and
 // output. The aggregation returned from the returned ComputeAggregation
 // function will always only return values from the previous collection cycle.
-func (b Builder[N]) PrecomputedLastValue() (Measure[N], ComputeAggregation) {
+// The returned Bind is nil as observations are not bound.
+func (b Builder[N]) PrecomputedLastValue() (Measure[N], ComputeAggregation, Bind[N]) {
 	lv := newPrecomputedLastValue[N](b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(lv.measure), lv.delta
+		return b.filter(lv.measure), lv.delta, nil
 	default:
-		return b.filter(lv.measure), lv.cumulative
+		return b.filter(lv.measure), lv.cumulative, nil
 	}
 }
 
 // PrecomputedSum returns a sum aggregate function input and output. The
 // arguments passed to the input are expected to be the precomputed sum values.
-func (b Builder[N]) PrecomputedSum(monotonic bool) (Measure[N], ComputeAggregation) {
+// The returned Bind is nil as observations are not bound.
+func (b Builder[N]) PrecomputedSum(monotonic bool) (Measure[N], ComputeAggregation, Bind[N]) {
 	s := newPrecomputedSum[N](monotonic, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(s.measure), s.delta
+		return b.filter(s.measure), s.delta, nil
 	default:
-		return b.filter(s.measure), s.cumulative
+		return b.filter(s.measure), s.cumulative, nil
 	}
 }
 
-// Sum returns a sum aggregate function input and output.
-func (b Builder[N]) Sum(monotonic bool) (Measure[N], ComputeAggregation) {
+// Sum returns a sum aggregate function input, output, and input binder.
+func (b Builder[N]) Sum(monotonic bool) (Measure[N], ComputeAggregation, Bind[N]) {
 	s := newSum[N](monotonic, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(s.measure), s.delta
+		return b.filter(s.measure), s.delta, b.bind(s.bind)
 	default:
-		return b.filter(s.measure), s.cumulative
+		s.evict = newEvictor(b.Eviction, s.start)
+		return b.filter(s.measure), s.cumulative, b.bind(s.bind)
 	}
 }
 
-// ExplicitBucketHistogram returns a histogram aggregate function input and
-// output.
-func (b Builder[N]) ExplicitBucketHistogram(boundaries []float64, noMinMax, noSum bool) (Measure[N], ComputeAggregation) {
+// ExplicitBucketHistogram returns a histogram aggregate function input,
+// output, and input binder.
+func (b Builder[N]) ExplicitBucketHistogram(boundaries []float64, noMinMax, noSum bool) (Measure[N], ComputeAggregation, Bind[N]) {
 	h := newHistogram[N](boundaries, noMinMax, noSum, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(h.measure), h.delta
+		return b.filter(h.measure), h.delta, b.bind(h.bind)
 	default:
-		return b.filter(h.measure), h.cumulative
+		h.evict = newEvictor(b.Eviction, h.start)
+		return b.filter(h.measure), h.cumulative, b.bind(h.bind)
 	}
 }
 
-// ExponentialBucketHistogram returns a histogram aggregate function input and
-// output.
-func (b Builder[N]) ExponentialBucketHistogram(maxSize, maxScale int32, noMinMax, noSum bool) (Measure[N], ComputeAggregation) {
+// ExponentialBucketHistogram returns a histogram aggregate function input,
+// output, and input binder.
+func (b Builder[N]) ExponentialBucketHistogram(maxSize, maxScale int32, noMinMax, noSum bool) (Measure[N], ComputeAggregation, Bind[N]) {
 	h := newExponentialHistogram[N](maxSize, maxScale, noMinMax, noSum, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(h.measure), h.delta
+		return b.filter(h.measure), h.delta, b.bind(h.bind)
 	default:
-		return b.filter(h.measure), h.cumulative
+		h.evict = newEvictor(b.Eviction, h.start)
+		return b.filter(h.measure), h.cumulative, b.bind(h.bind)
 	}
 }
 
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/internal/exemplar"
@@ -105,6 +106,112 @@ func testBuilderFilter[N int64 | float64]() func(t *testing.T) {
 	}
 }
 
+func TestBuilderBind(t *testing.T) {
+	t.Run("Int64", testBuilderBind[int64]())
+	t.Run("Float64", testBuilderBind[float64]())
+}
+
+func testBuilderBind[N int64 | float64]() func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		value, attr := N(1), alice
+		run := func(b Builder[N], wantF attribute.Set, wantD []attribute.KeyValue) func(*testing.T) {
+			return func(t *testing.T) {
+				t.Helper()
+
+				var calls int
+				bind := b.bind(func(f attribute.Set, d []attribute.KeyValue) BoundMeasure[N] {
+					calls++
+					assert.Equal(t, wantF, f, "bound incorrect filtered attributes")
+					assert.ElementsMatch(t, wantD, d, "bound incorrect dropped attributes")
+					return func(_ context.Context, v N) {
+						assert.Equal(t, value, v, "measured incorrect value")
+					}
+				})
+				meas := bind(attr)
+				meas(context.Background(), value)
+				meas(context.Background(), value)
+				assert.Equal(t, 1, calls, "attributes not filtered once")
+			}
+		}
+
+		t.Run("NoFilter", run(Builder[N]{}, attr, nil))
+		t.Run("Filter", run(Builder[N]{Filter: attrFltr}, fltrAlice, []attribute.KeyValue{adminTrue}))
+	}
+}
+
+func TestBoundMeasure(t *testing.T) {
+	orig := now
+	now = func() time.Time { return y2k }
+	t.Cleanup(func() { now = orig })
+
+	builders := map[string]func(Builder[int64]) (Measure[int64], ComputeAggregation, Bind[int64]){
+		"Sum": func(b Builder[int64]) (Measure[int64], ComputeAggregation, Bind[int64]) {
+			return b.Sum(true)
+		},
+		"LastValue": func(b Builder[int64]) (Measure[int64], ComputeAggregation, Bind[int64]) {
+			return b.LastValue()
+		},
+		"ExplicitBucketHistogram": func(b Builder[int64]) (Measure[int64], ComputeAggregation, Bind[int64]) {
+			return b.ExplicitBucketHistogram([]float64{1, 10}, false, false)
+		},
+		"ExponentialBucketHistogram": func(b Builder[int64]) (Measure[int64], ComputeAggregation, Bind[int64]) {
+			return b.ExponentialBucketHistogram(160, 20, false, false)
+		},
+	}
+	temporalities := map[string]metricdata.Temporality{
+		"Delta":      metricdata.DeltaTemporality,
+		"Cumulative": metricdata.CumulativeTemporality,
+	}
+
+	ctx := context.Background()
+	for name, build := range builders {
+		for tName, temporality := range temporalities {
+			t.Run(name+"/"+tName, func(t *testing.T) {
+				b := Builder[int64]{
+					Temporality:      temporality,
+					Filter:           attrFltr,
+					AggregationLimit: 3,
+					Eviction:         Eviction{MaxIdleCycles: 1},
+				}
+				meas, wantComp, _ := build(b)
+				_, gotComp, bind := build(b)
+				require.NotNil(t, bind)
+
+				sets := []attribute.Set{alice, bob, carol, dave}
+				bound := make([]BoundMeasure[int64], len(sets))
+				for i, set := range sets {
+					bound[i] = bind(set)
+				}
+				measure := func(values ...int64) {
+					for i, v := range values {
+						if v == 0 {
+							continue
+						}
+						meas(ctx, v, sets[i])
+						bound[i](ctx, v)
+					}
+				}
+				assertEqual := func(msg string) {
+					t.Helper()
+					var want, got metricdata.Aggregation
+					assert.Equal(t, wantComp(&want), gotComp(&got), msg)
+					metricdatatest.AssertAggregationsEqual(t, want, got, metricdatatest.IgnoreExemplars())
+				}
+
+				measure(1, 2, 3, 4)
+				assertEqual("overflow")
+				measure(5, 0, 6, 0)
+				assertEqual("partial")
+				assertEqual("idle")
+				measure(0, 7, 0, 8)
+				assertEqual("evicted or cleared")
+			})
+		}
+	}
+}
+
 type arg[N int64 | float64] struct {
 	ctx context.Context
 
@@ -139,7 +246,7 @@ func test[N int64 | float64](meas Measure[N], comp ComputeAggregation, steps []t
 	}
 }
 
-func benchmarkAggregate[N int64 | float64](factory func() (Measure[N], ComputeAggregation)) func(*testing.B) {
+func benchmarkAggregate[N int64 | float64](factory func() (Measure[N], ComputeAggregation, Bind[N])) func(*testing.B) {
 	counts := []int{1, 10, 100}
 	return func(b *testing.B) {
 		for _, n := range counts {
@@ -152,7 +259,7 @@ func benchmarkAggregate[N int64 | float64](factory func() (Measure[N], ComputeAg
 
 var bmarkRes metricdata.Aggregation
 
-func benchmarkAggregateN[N int64 | float64](b *testing.B, factory func() (Measure[N], ComputeAggregation), count int) {
+func benchmarkAggregateN[N int64 | float64](b *testing.B, factory func() (Measure[N], ComputeAggregation, Bind[N]), count int) {
 	ctx := context.Background()
 	attrs := make([]attribute.Set, count)
 	for i := range attrs {
@@ -161,7 +268,7 @@ func benchmarkAggregateN[N int64 | float64](b *testing.B, factory func() (Measur
 
 	b.Run("Measure", func(b *testing.B) {
 		got := &bmarkRes
-		meas, comp := factory()
+		meas, comp, _ := factory()
 		b.ReportAllocs()
 		b.ResetTimer()
 
@@ -174,10 +281,31 @@ func benchmarkAggregateN[N int64 | float64](b *testing.B, factory func() (Measur
 		comp(got)
 	})
 
+	if _, _, bind := factory(); bind != nil {
+		b.Run("BoundMeasure", func(b *testing.B) {
+			got := &bmarkRes
+			_, comp, bind := factory()
+			bound := make([]BoundMeasure[N], len(attrs))
+			for i, attr := range attrs {
+				bound[i] = bind(attr)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for n := 0; n < b.N; n++ {
+				for _, meas := range bound {
+					meas(ctx, 1)
+				}
+			}
+
+			comp(got)
+		})
+	}
+
 	b.Run("ComputeAggregation", func(b *testing.B) {
 		comps := make([]ComputeAggregation, b.N)
 		for n := range comps {
-			meas, comp := factory()
+			meas, comp, _ := factory()
 			for _, attr := range attrs {
 				meas(ctx, 1, attr)
 			}
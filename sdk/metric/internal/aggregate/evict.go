@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Eviction is the policy used by cumulative aggregate functions to forget the
+// attribute sets that are no longer measured.
+type Eviction struct {
+	// MaxIdleCycles is the number of consecutive collection cycles without
+	// measurement after which an attribute set is evicted. If it is less
+	// than or equal to zero, attribute sets are not evicted based on the
+	// number of collection cycles.
+	MaxIdleCycles int
+	// MaxIdleTime is the time without measurement after which an attribute
+	// set is evicted. If it is less than or equal to zero, attribute sets are
+	// not evicted based on time.
+	MaxIdleTime time.Duration
+	// OnEvict, if not nil, is called with each evicted attribute set. It is
+	// called during the collection, while the aggregate function is locked.
+	OnEvict func(attribute.Set)
+}
+
+// enabled returns if attribute sets are evicted with e.
+func (e Eviction) enabled() bool {
+	return e.MaxIdleCycles > 0 || e.MaxIdleTime > 0
+}
+
+// staleness tracks the measurements of the aggregate of an attribute set.
+type staleness struct {
+	// start is the start time of the aggregate if eviction is enabled.
+	start time.Time
+	// updated is the time of the last measurement.
+	updated time.Time
+	// idle is the number of collection cycles since the last measurement.
+	idle int
+}
+
+// update records a measurement made at t.
+func (s *staleness) update(t time.Time) {
+	s.updated = t
+	s.idle = 0
+}
+
+// evictor evicts the stale attribute sets of a cumulative aggregate function.
+// The zero-value evictor does not evict any attribute set.
+type evictor struct {
+	Eviction
+
+	// cycleStart is the start time of the current collection cycle. It is
+	// the start time of the attribute sets first measured during the cycle.
+	cycleStart time.Time
+}
+
+// newEvictor returns an evictor that evicts attribute sets with e from an
+// aggregate function started at start.
+func newEvictor(e Eviction, start time.Time) evictor {
+	return evictor{Eviction: e, cycleStart: start}
+}
+
+// newStaleness returns the staleness of an attribute set first measured at t.
+func (e *evictor) newStaleness(t time.Time) staleness {
+	return staleness{start: e.cycleStart, updated: t}
+}
+
+// stale returns if the attribute set with staleness s is stale at t.
+func (e *evictor) stale(s staleness, t time.Time) bool {
+	if e.MaxIdleCycles > 0 && s.idle >= e.MaxIdleCycles {
+		return true
+	}
+	return e.MaxIdleTime > 0 && t.Sub(s.updated) >= e.MaxIdleTime
+}
+
+// evicted reports the eviction of attrs.
+func (e *evictor) evicted(attrs attribute.Set) {
+	if e.OnEvict != nil {
+		e.OnEvict(attrs)
+	}
+}
+
+// startTime returns the start time of the aggregate of an attribute set with
+// staleness s, where aggStart is the start time of the aggregate function.
+//
+// Attribute sets evicted and measured again restart from zero, so their start
+// time is the start of the collection cycle of their first measurement.
+func (e *evictor) startTime(s staleness, aggStart time.Time) time.Time {
+	if e.enabled() {
+		return s.start
+	}
+	return aggStart
+}
@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// startTimes returns the start time of the data-points of agg by attribute
+// set.
+func startTimes(t *testing.T, agg metricdata.Aggregation) map[attribute.Distinct]time.Time {
+	t.Helper()
+
+	out := make(map[attribute.Distinct]time.Time)
+	switch a := agg.(type) {
+	case metricdata.Sum[int64]:
+		for _, dPt := range a.DataPoints {
+			out[dPt.Attributes.Equivalent()] = dPt.StartTime
+		}
+	case metricdata.Gauge[int64]:
+		for _, dPt := range a.DataPoints {
+			out[dPt.Attributes.Equivalent()] = dPt.StartTime
+		}
+	case metricdata.Histogram[int64]:
+		for _, dPt := range a.DataPoints {
+			out[dPt.Attributes.Equivalent()] = dPt.StartTime
+		}
+	case metricdata.ExponentialHistogram[int64]:
+		for _, dPt := range a.DataPoints {
+			out[dPt.Attributes.Equivalent()] = dPt.StartTime
+		}
+	default:
+		require.Failf(t, "unexpected aggregation", "%T", agg)
+	}
+	return out
+}
+
+func TestEviction(t *testing.T) {
+	var tNow time.Time
+	orig := now
+	now = func() time.Time { return tNow }
+	t.Cleanup(func() { now = orig })
+
+	builders := map[string]func(Builder[int64]) (Measure[int64], ComputeAggregation, Bind[int64]){
+		"Sum": func(b Builder[int64]) (Measure[int64], ComputeAggregation, Bind[int64]) {
+			return b.Sum(true)
+		},
+		"LastValue": func(b Builder[int64]) (Measure[int64], ComputeAggregation, Bind[int64]) {
+			return b.LastValue()
+		},
+		"ExplicitBucketHistogram": func(b Builder[int64]) (Measure[int64], ComputeAggregation, Bind[int64]) {
+			return b.ExplicitBucketHistogram([]float64{1, 10}, false, false)
+		},
+		"ExponentialBucketHistogram": func(b Builder[int64]) (Measure[int64], ComputeAggregation, Bind[int64]) {
+			return b.ExponentialBucketHistogram(160, 20, false, false)
+		},
+	}
+
+	ctx := context.Background()
+	for name, build := range builders {
+		t.Run(name, func(t *testing.T) {
+			t.Run("MaxIdleCycles", func(t *testing.T) {
+				tNow = y2k
+				var evicted []attribute.Set
+				meas, comp, _ := build(Builder[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					Eviction: Eviction{
+						MaxIdleCycles: 2,
+						OnEvict:       func(s attribute.Set) { evicted = append(evicted, s) },
+					},
+				})
+				collect := func(n int64) map[attribute.Distinct]time.Time {
+					tNow = y2kPlus(n)
+					var got metricdata.Aggregation
+					comp(&got)
+					return startTimes(t, got)
+				}
+
+				meas(ctx, 1, alice)
+				meas(ctx, 1, bob)
+				assert.Equal(t, map[attribute.Distinct]time.Time{
+					alice.Equivalent(): y2k,
+					bob.Equivalent():   y2k,
+				}, collect(1))
+
+				meas(ctx, 1, alice)
+				assert.Len(t, collect(2), 2, "bob idle for 1 cycle")
+				assert.Empty(t, evicted)
+
+				meas(ctx, 1, alice)
+				assert.Equal(t, map[attribute.Distinct]time.Time{
+					alice.Equivalent(): y2k,
+				}, collect(3), "bob idle for 2 cycles")
+				assert.Equal(t, []attribute.Set{bob}, evicted)
+
+				// Bob restarts with the collection cycle it is measured in.
+				meas(ctx, 1, bob)
+				assert.Equal(t, map[attribute.Distinct]time.Time{
+					alice.Equivalent(): y2k,
+					bob.Equivalent():   y2kPlus(3),
+				}, collect(4))
+
+				assert.Equal(t, map[attribute.Distinct]time.Time{
+					bob.Equivalent(): y2kPlus(3),
+				}, collect(5), "alice idle for 2 cycles")
+				assert.Equal(t, []attribute.Set{bob, alice}, evicted)
+			})
+
+			t.Run("MaxIdleTime", func(t *testing.T) {
+				tNow = y2k
+				meas, comp, _ := build(Builder[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					Eviction:    Eviction{MaxIdleTime: 10 * time.Second},
+				})
+				collect := func(n int64) int {
+					tNow = y2kPlus(n)
+					var got metricdata.Aggregation
+					return comp(&got)
+				}
+
+				tNow = y2kPlus(1)
+				meas(ctx, 1, alice)
+				tNow = y2kPlus(5)
+				meas(ctx, 1, bob)
+
+				assert.Equal(t, 2, collect(10))
+				assert.Equal(t, 1, collect(11), "alice idle for 10s")
+				assert.Equal(t, 1, collect(14))
+				assert.Equal(t, 0, collect(15), "bob idle for 10s")
+			})
+
+			t.Run("Delta", func(t *testing.T) {
+				tNow = y2k
+				var evicted int
+				meas, comp, _ := build(Builder[int64]{
+					Temporality: metricdata.DeltaTemporality,
+					Eviction: Eviction{
+						MaxIdleCycles: 1,
+						OnEvict:       func(attribute.Set) { evicted++ },
+					},
+				})
+				meas(ctx, 1, alice)
+				var got metricdata.Aggregation
+				assert.Equal(t, 1, comp(&got))
+				assert.Equal(t, 0, comp(&got))
+				assert.Equal(t, 0, evicted, "delta aggregations do not evict")
+			})
+		})
+	}
+}
+
+func TestEvictionValueRestart(t *testing.T) {
+	var tNow time.Time
+	orig := now
+	now = func() time.Time { return tNow }
+	t.Cleanup(func() { now = orig })
+
+	tNow = y2k
+	meas, comp, _ := Builder[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		Eviction:    Eviction{MaxIdleCycles: 1},
+	}.Sum(true)
+
+	ctx := context.Background()
+	collect := func() metricdata.Sum[int64] {
+		tNow = tNow.Add(time.Second)
+		var got metricdata.Aggregation
+		comp(&got)
+		require.IsType(t, metricdata.Sum[int64]{}, got)
+		return got.(metricdata.Sum[int64])
+	}
+
+	meas(ctx, 5, alice)
+	sum := collect()
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, int64(5), sum.DataPoints[0].Value)
+
+	assert.Empty(t, collect().DataPoints, "alice evicted")
+
+	meas(ctx, 2, alice)
+	sum = collect()
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, int64(2), sum.DataPoints[0].Value, "evicted sum not reset")
+	assert.Equal(t, y2kPlus(2), sum.DataPoints[0].StartTime)
+}
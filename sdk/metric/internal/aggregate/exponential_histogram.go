@@ -32,6 +32,7 @@ const (
 type expoHistogramDataPoint[N int64 | float64] struct {
 	attrs attribute.Set
 	res   exemplar.Reservoir
+	staleness
 
 	count uint64
 	min   N
@@ -309,10 +310,30 @@ type expoHistogram[N int64 | float64] struct {
 	limit    limiter[*expoHistogramDataPoint[N]]
 	values   map[attribute.Distinct]*expoHistogramDataPoint[N]
 	valuesMu sync.Mutex
+	evict    evictor
+	// gen is incremented each time data points are removed from values. It
+	// invalidates the data points cached by bound measures.
+	gen uint64
 
 	start time.Time
 }
 
+// lookup returns the data point for fltrAttr, or the overflow data point if
+// the cardinality limit is reached. The data point is created if it does not
+// exist. The expoHistogram needs to be locked by the caller.
+func (e *expoHistogram[N]) lookup(fltrAttr attribute.Set, t time.Time) *expoHistogramDataPoint[N] {
+	attr := e.limit.Attributes(fltrAttr, e.values)
+	v, ok := e.values[attr.Equivalent()]
+	if !ok {
+		v = newExpoHistogramDataPoint[N](attr, e.maxSize, e.maxScale, e.noMinMax, e.noSum)
+		v.res = e.newRes()
+		v.staleness = e.evict.newStaleness(t)
+
+		e.values[attr.Equivalent()] = v
+	}
+	return v
+}
+
 func (e *expoHistogram[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
 	// Ignore NaN and infinity.
 	if math.IsInf(float64(value), 0) || math.IsNaN(float64(value)) {
@@ -324,18 +345,38 @@ func (e *expoHistogram[N]) measure(ctx context.Context, value N, fltrAttr attrib
 	e.valuesMu.Lock()
 	defer e.valuesMu.Unlock()
 
-	attr := e.limit.Attributes(fltrAttr, e.values)
-	v, ok := e.values[attr.Equivalent()]
-	if !ok {
-		v = newExpoHistogramDataPoint[N](attr, e.maxSize, e.maxScale, e.noMinMax, e.noSum)
-		v.res = e.newRes()
-
-		e.values[attr.Equivalent()] = v
-	}
+	v := e.lookup(fltrAttr, t)
 	v.record(value)
+	v.update(t)
 	v.res.Offer(ctx, t, exemplar.NewValue(value), droppedAttr)
 }
 
+func (e *expoHistogram[N]) bind(fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) BoundMeasure[N] {
+	// The cached data point is only accessed while e is locked.
+	var (
+		v   *expoHistogramDataPoint[N]
+		gen uint64
+	)
+	return func(ctx context.Context, value N) {
+		// Ignore NaN and infinity.
+		if math.IsInf(float64(value), 0) || math.IsNaN(float64(value)) {
+			return
+		}
+
+		t := now()
+
+		e.valuesMu.Lock()
+		defer e.valuesMu.Unlock()
+
+		if v == nil || gen != e.gen {
+			v, gen = e.lookup(fltrAttr, t), e.gen
+		}
+		v.record(value)
+		v.update(t)
+		v.res.Offer(ctx, t, exemplar.NewValue(value), droppedAttr)
+	}
+}
+
 func (e *expoHistogram[N]) delta(dest *metricdata.Aggregation) int {
 	t := now()
 
@@ -382,6 +423,7 @@ func (e *expoHistogram[N]) delta(dest *metricdata.Aggregation) int {
 	}
 	// Unused attribute sets do not report.
 	clear(e.values)
+	e.gen++
 
 	e.start = t
 	h.DataPoints = hDPts
@@ -404,9 +446,16 @@ func (e *expoHistogram[N]) cumulative(dest *metricdata.Aggregation) int {
 	hDPts := reset(h.DataPoints, n, n)
 
 	var i int
-	for _, val := range e.values {
+	for key, val := range e.values {
+		if e.evict.stale(val.staleness, t) {
+			delete(e.values, key)
+			e.gen++
+			e.evict.evicted(val.attrs)
+			continue
+		}
+
 		hDPts[i].Attributes = val.attrs
-		hDPts[i].StartTime = e.start
+		hDPts[i].StartTime = e.evict.startTime(val.staleness, e.start)
 		hDPts[i].Time = t
 		hDPts[i].Count = val.count
 		hDPts[i].Scale = int32(val.scale)
@@ -431,14 +480,12 @@ func (e *expoHistogram[N]) cumulative(dest *metricdata.Aggregation) int {
 
 		collectExemplars(&hDPts[i].Exemplars, val.res.Collect)
 
+		val.idle++
 		i++
-		// TODO (#3006): This will use an unbounded amount of memory if there
-		// are unbounded number of attribute sets being aggregated. Attribute
-		// sets that become "stale" need to be forgotten so this will not
-		// overload the system.
 	}
+	e.evict.cycleStart = t
 
-	h.DataPoints = hDPts
+	h.DataPoints = hDPts[:i]
 	*dest = h
-	return n
+	return i
 }
@@ -680,22 +680,22 @@ func BenchmarkExponentialHistogram(b *testing.B) {
 		noSum    = false
 	)
 
-	b.Run("Int64/Cumulative", benchmarkAggregate(func() (Measure[int64], ComputeAggregation) {
+	b.Run("Int64/Cumulative", benchmarkAggregate(func() (Measure[int64], ComputeAggregation, Bind[int64]) {
 		return Builder[int64]{
 			Temporality: metricdata.CumulativeTemporality,
 		}.ExponentialBucketHistogram(maxSize, maxScale, noMinMax, noSum)
 	}))
-	b.Run("Int64/Delta", benchmarkAggregate(func() (Measure[int64], ComputeAggregation) {
+	b.Run("Int64/Delta", benchmarkAggregate(func() (Measure[int64], ComputeAggregation, Bind[int64]) {
 		return Builder[int64]{
 			Temporality: metricdata.DeltaTemporality,
 		}.ExponentialBucketHistogram(maxSize, maxScale, noMinMax, noSum)
 	}))
-	b.Run("Float64/Cumulative", benchmarkAggregate(func() (Measure[float64], ComputeAggregation) {
+	b.Run("Float64/Cumulative", benchmarkAggregate(func() (Measure[float64], ComputeAggregation, Bind[float64]) {
 		return Builder[float64]{
 			Temporality: metricdata.CumulativeTemporality,
 		}.ExponentialBucketHistogram(maxSize, maxScale, noMinMax, noSum)
 	}))
-	b.Run("Float64/Delta", benchmarkAggregate(func() (Measure[float64], ComputeAggregation) {
+	b.Run("Float64/Delta", benchmarkAggregate(func() (Measure[float64], ComputeAggregation, Bind[float64]) {
 		return Builder[float64]{
 			Temporality: metricdata.DeltaTemporality,
 		}.ExponentialBucketHistogram(maxSize, maxScale, noMinMax, noSum)
@@ -743,7 +743,7 @@ func TestExponentialHistogramAggregation(t *testing.T) {
 }
 
 func testDeltaExpoHist[N int64 | float64]() func(t *testing.T) {
-	in, out := Builder[N]{
+	in, out, _ := Builder[N]{
 		Temporality:      metricdata.DeltaTemporality,
 		Filter:           attrFltr,
 		AggregationLimit: 2,
@@ -870,7 +870,7 @@ func testDeltaExpoHist[N int64 | float64]() func(t *testing.T) {
 }
 
 func testCumulativeExpoHist[N int64 | float64]() func(t *testing.T) {
-	in, out := Builder[N]{
+	in, out, _ := Builder[N]{
 		Temporality:      metricdata.CumulativeTemporality,
 		Filter:           attrFltr,
 		AggregationLimit: 2,
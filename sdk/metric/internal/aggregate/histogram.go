@@ -18,6 +18,7 @@ import (
 type buckets[N int64 | float64] struct {
 	attrs attribute.Set
 	res   exemplar.Reservoir
+	staleness
 
 	counts   []uint64
 	count    uint64
@@ -52,6 +53,10 @@ type histValues[N int64 | float64] struct {
 	limit    limiter[*buckets[N]]
 	values   map[attribute.Distinct]*buckets[N]
 	valuesMu sync.Mutex
+	evict    evictor
+	// gen is incremented each time buckets are removed from values. It
+	// invalidates the buckets cached by bound measures.
+	gen uint64
 }
 
 func newHistValues[N int64 | float64](bounds []float64, noSum bool, limit int, r func() exemplar.Reservoir) *histValues[N] {
@@ -70,21 +75,11 @@ func newHistValues[N int64 | float64](bounds []float64, noSum bool, limit int, r
 	}
 }
 
-// Aggregate records the measurement value, scoped by attr, and aggregates it
-// into a histogram.
-func (s *histValues[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
-	// This search will return an index in the range [0, len(s.bounds)], where
-	// it will return len(s.bounds) if value is greater than the last element
-	// of s.bounds. This aligns with the buckets in that the length of buckets
-	// is len(s.bounds)+1, with the last bucket representing:
-	// (s.bounds[len(s.bounds)-1], +∞).
-	idx := sort.SearchFloat64s(s.bounds, float64(value))
-
-	t := now()
-
-	s.valuesMu.Lock()
-	defer s.valuesMu.Unlock()
-
+// lookup returns the buckets for fltrAttr, or the overflow buckets if the
+// cardinality limit is reached. The buckets are created for the first
+// measurement value if they do not exist. The histValues needs to be locked
+// by the caller.
+func (s *histValues[N]) lookup(fltrAttr attribute.Set, t time.Time, value N) *buckets[N] {
 	attr := s.limit.Attributes(fltrAttr, s.values)
 	b, ok := s.values[attr.Equivalent()]
 	if !ok {
@@ -97,18 +92,65 @@ func (s *histValues[N]) measure(ctx context.Context, value N, fltrAttr attribute
 		//   buckets = (-∞, 0], (0, 5.0], (5.0, 10.0], (10.0, +∞)
 		b = newBuckets[N](attr, len(s.bounds)+1)
 		b.res = s.newRes()
+		b.staleness = s.evict.newStaleness(t)
 
 		// Ensure min and max are recorded values (not zero), for new buckets.
 		b.min, b.max = value, value
 		s.values[attr.Equivalent()] = b
 	}
+	return b
+}
+
+// record records the measurement value, binned at idx, into b. The
+// histValues needs to be locked by the caller.
+func (s *histValues[N]) record(ctx context.Context, b *buckets[N], t time.Time, idx int, value N, droppedAttr []attribute.KeyValue) {
 	b.bin(idx, value)
+	b.update(t)
 	if !s.noSum {
 		b.sum(value)
 	}
 	b.res.Offer(ctx, t, exemplar.NewValue(value), droppedAttr)
 }
 
+// Aggregate records the measurement value, scoped by attr, and aggregates it
+// into a histogram.
+func (s *histValues[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
+	// This search will return an index in the range [0, len(s.bounds)], where
+	// it will return len(s.bounds) if value is greater than the last element
+	// of s.bounds. This aligns with the buckets in that the length of buckets
+	// is len(s.bounds)+1, with the last bucket representing:
+	// (s.bounds[len(s.bounds)-1], +∞).
+	idx := sort.SearchFloat64s(s.bounds, float64(value))
+
+	t := now()
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	s.record(ctx, s.lookup(fltrAttr, t, value), t, idx, value, droppedAttr)
+}
+
+func (s *histValues[N]) bind(fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) BoundMeasure[N] {
+	// The cached buckets are only accessed while s is locked.
+	var (
+		b   *buckets[N]
+		gen uint64
+	)
+	return func(ctx context.Context, value N) {
+		idx := sort.SearchFloat64s(s.bounds, float64(value))
+
+		t := now()
+
+		s.valuesMu.Lock()
+		defer s.valuesMu.Unlock()
+
+		if b == nil || gen != s.gen {
+			b, gen = s.lookup(fltrAttr, t, value), s.gen
+		}
+		s.record(ctx, b, t, idx, value, droppedAttr)
+	}
+}
+
 // newHistogram returns an Aggregator that summarizes a set of measurements as
 // an histogram.
 func newHistogram[N int64 | float64](boundaries []float64, noMinMax, noSum bool, limit int, r func() exemplar.Reservoir) *histogram[N] {
@@ -169,6 +211,7 @@ func (s *histogram[N]) delta(dest *metricdata.Aggregation) int {
 	}
 	// Unused attribute sets do not report.
 	clear(s.values)
+	s.gen++
 	// The delta collection cycle resets.
 	s.start = t
 
@@ -196,9 +239,16 @@ func (s *histogram[N]) cumulative(dest *metricdata.Aggregation) int {
 	hDPts := reset(h.DataPoints, n, n)
 
 	var i int
-	for _, val := range s.values {
+	for key, val := range s.values {
+		if s.evict.stale(val.staleness, t) {
+			delete(s.values, key)
+			s.gen++
+			s.evict.evicted(val.attrs)
+			continue
+		}
+
 		hDPts[i].Attributes = val.attrs
-		hDPts[i].StartTime = s.start
+		hDPts[i].StartTime = s.evict.startTime(val.staleness, s.start)
 		hDPts[i].Time = t
 		hDPts[i].Count = val.count
 		hDPts[i].Bounds = bounds
@@ -221,15 +271,13 @@ func (s *histogram[N]) cumulative(dest *metricdata.Aggregation) int {
 
 		collectExemplars(&hDPts[i].Exemplars, val.res.Collect)
 
+		val.idle++
 		i++
-		// TODO (#3006): This will use an unbounded amount of memory if there
-		// are unbounded number of attribute sets being aggregated. Attribute
-		// sets that become "stale" need to be forgotten so this will not
-		// overload the system.
 	}
+	s.evict.cycleStart = t
 
-	h.DataPoints = hDPts
+	h.DataPoints = hDPts[:i]
 	*dest = h
 
-	return n
+	return i
 }
@@ -50,7 +50,7 @@ type conf[N int64 | float64] struct {
 }
 
 func testDeltaHist[N int64 | float64](c conf[N]) func(t *testing.T) {
-	in, out := Builder[N]{
+	in, out, _ := Builder[N]{
 		Temporality:      metricdata.DeltaTemporality,
 		Filter:           attrFltr,
 		AggregationLimit: 3,
@@ -137,7 +137,7 @@ func testDeltaHist[N int64 | float64](c conf[N]) func(t *testing.T) {
 }
 
 func testCumulativeHist[N int64 | float64](c conf[N]) func(t *testing.T) {
-	in, out := Builder[N]{
+	in, out, _ := Builder[N]{
 		Temporality:      metricdata.CumulativeTemporality,
 		Filter:           attrFltr,
 		AggregationLimit: 3,
@@ -373,22 +373,22 @@ func TestDeltaHistogramReset(t *testing.T) {
 }
 
 func BenchmarkHistogram(b *testing.B) {
-	b.Run("Int64/Cumulative", benchmarkAggregate(func() (Measure[int64], ComputeAggregation) {
+	b.Run("Int64/Cumulative", benchmarkAggregate(func() (Measure[int64], ComputeAggregation, Bind[int64]) {
 		return Builder[int64]{
 			Temporality: metricdata.CumulativeTemporality,
 		}.ExplicitBucketHistogram(bounds, noMinMax, false)
 	}))
-	b.Run("Int64/Delta", benchmarkAggregate(func() (Measure[int64], ComputeAggregation) {
+	b.Run("Int64/Delta", benchmarkAggregate(func() (Measure[int64], ComputeAggregation, Bind[int64]) {
 		return Builder[int64]{
 			Temporality: metricdata.DeltaTemporality,
 		}.ExplicitBucketHistogram(bounds, noMinMax, false)
 	}))
-	b.Run("Float64/Cumulative", benchmarkAggregate(func() (Measure[float64], ComputeAggregation) {
+	b.Run("Float64/Cumulative", benchmarkAggregate(func() (Measure[float64], ComputeAggregation, Bind[float64]) {
 		return Builder[float64]{
 			Temporality: metricdata.CumulativeTemporality,
 		}.ExplicitBucketHistogram(bounds, noMinMax, false)
 	}))
-	b.Run("Float64/Delta", benchmarkAggregate(func() (Measure[float64], ComputeAggregation) {
+	b.Run("Float64/Delta", benchmarkAggregate(func() (Measure[float64], ComputeAggregation, Bind[float64]) {
 		return Builder[float64]{
 			Temporality: metricdata.DeltaTemporality,
 		}.ExplicitBucketHistogram(bounds, noMinMax, false)
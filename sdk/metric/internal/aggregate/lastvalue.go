@@ -19,13 +19,15 @@ type datapoint[N int64 | float64] struct {
 	timestamp time.Time
 	value     N
 	res       exemplar.Reservoir
+
+	staleness
 }
 
 func newLastValue[N int64 | float64](limit int, r func() exemplar.Reservoir) *lastValue[N] {
 	return &lastValue[N]{
 		newRes: r,
-		limit:  newLimiter[datapoint[N]](limit),
-		values: make(map[attribute.Distinct]datapoint[N]),
+		limit:  newLimiter[*datapoint[N]](limit),
+		values: make(map[attribute.Distinct]*datapoint[N]),
 		start:  now(),
 	}
 }
@@ -35,9 +37,30 @@ type lastValue[N int64 | float64] struct {
 	sync.Mutex
 
 	newRes func() exemplar.Reservoir
-	limit  limiter[datapoint[N]]
-	values map[attribute.Distinct]datapoint[N]
+	limit  limiter[*datapoint[N]]
+	values map[attribute.Distinct]*datapoint[N]
 	start  time.Time
+	evict  evictor
+	// gen is incremented each time datapoints are removed from values. It
+	// invalidates the datapoints cached by bound measures.
+	gen uint64
+}
+
+// lookup returns the datapoint for fltrAttr, or the overflow datapoint if the
+// cardinality limit is reached. The datapoint is created if it does not
+// exist. The lastValue needs to be locked by the caller.
+func (s *lastValue[N]) lookup(fltrAttr attribute.Set, t time.Time) *datapoint[N] {
+	attr := s.limit.Attributes(fltrAttr, s.values)
+	d, ok := s.values[attr.Equivalent()]
+	if !ok {
+		d = &datapoint[N]{
+			attrs:     attr,
+			res:       s.newRes(),
+			staleness: s.evict.newStaleness(t),
+		}
+		s.values[attr.Equivalent()] = d
+	}
+	return d
 }
 
 func (s *lastValue[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
@@ -46,18 +69,33 @@ func (s *lastValue[N]) measure(ctx context.Context, value N, fltrAttr attribute.
 	s.Lock()
 	defer s.Unlock()
 
-	attr := s.limit.Attributes(fltrAttr, s.values)
-	d, ok := s.values[attr.Equivalent()]
-	if !ok {
-		d.res = s.newRes()
-	}
-
-	d.attrs = attr
+	d := s.lookup(fltrAttr, t)
 	d.timestamp = t
 	d.value = value
+	d.update(t)
 	d.res.Offer(ctx, t, exemplar.NewValue(value), droppedAttr)
+}
 
-	s.values[attr.Equivalent()] = d
+func (s *lastValue[N]) bind(fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) BoundMeasure[N] {
+	// The cached datapoint is only accessed while s is locked.
+	var (
+		d   *datapoint[N]
+		gen uint64
+	)
+	return func(ctx context.Context, value N) {
+		t := now()
+
+		s.Lock()
+		defer s.Unlock()
+
+		if d == nil || gen != s.gen {
+			d, gen = s.lookup(fltrAttr, t), s.gen
+		}
+		d.timestamp = t
+		d.value = value
+		d.update(t)
+		d.res.Offer(ctx, t, exemplar.NewValue(value), droppedAttr)
+	}
 }
 
 func (s *lastValue[N]) delta(dest *metricdata.Aggregation) int {
@@ -71,6 +109,7 @@ func (s *lastValue[N]) delta(dest *metricdata.Aggregation) int {
 	n := s.copyDpts(&gData.DataPoints)
 	// Do not report stale values.
 	clear(s.values)
+	s.gen++
 	// Update start time for delta temporality.
 	s.start = now()
 
@@ -87,11 +126,25 @@ func (s *lastValue[N]) cumulative(dest *metricdata.Aggregation) int {
 	s.Lock()
 	defer s.Unlock()
 
+	if !s.evict.enabled() {
+		n := s.copyDpts(&gData.DataPoints)
+		*dest = gData
+		return n
+	}
+
+	t := now()
+	for key, v := range s.values {
+		if s.evict.stale(v.staleness, t) {
+			delete(s.values, key)
+			s.gen++
+			s.evict.evicted(v.attrs)
+		}
+	}
 	n := s.copyDpts(&gData.DataPoints)
-	// TODO (#3006): This will use an unbounded amount of memory if there
-	// are unbounded number of attribute sets being aggregated. Attribute
-	// sets that become "stale" need to be forgotten so this will not
-	// overload the system.
+	for _, v := range s.values {
+		v.idle++
+	}
+	s.evict.cycleStart = t
 	*dest = gData
 
 	return n
@@ -106,7 +159,7 @@ func (s *lastValue[N]) copyDpts(dest *[]metricdata.DataPoint[N]) int {
 	var i int
 	for _, v := range s.values {
 		(*dest)[i].Attributes = v.attrs
-		(*dest)[i].StartTime = s.start
+		(*dest)[i].StartTime = s.evict.startTime(v.staleness, s.start)
 		(*dest)[i].Time = v.timestamp
 		(*dest)[i].Value = v.value
 		collectExemplars(&(*dest)[i].Exemplars, v.res.Collect)
@@ -17,22 +17,45 @@ type sumValue[N int64 | float64] struct {
 	n     N
 	res   exemplar.Reservoir
 	attrs attribute.Set
+
+	staleness
 }
 
 // valueMap is the storage for sums.
 type valueMap[N int64 | float64] struct {
 	sync.Mutex
 	newRes func() exemplar.Reservoir
-	limit  limiter[sumValue[N]]
-	values map[attribute.Distinct]sumValue[N]
+	limit  limiter[*sumValue[N]]
+	values map[attribute.Distinct]*sumValue[N]
+	evict  evictor
+	// gen is incremented each time sums are removed from values. It
+	// invalidates the sums cached by bound measures.
+	gen uint64
 }
 
 func newValueMap[N int64 | float64](limit int, r func() exemplar.Reservoir) *valueMap[N] {
 	return &valueMap[N]{
 		newRes: r,
-		limit:  newLimiter[sumValue[N]](limit),
-		values: make(map[attribute.Distinct]sumValue[N]),
+		limit:  newLimiter[*sumValue[N]](limit),
+		values: make(map[attribute.Distinct]*sumValue[N]),
+	}
+}
+
+// lookup returns the sum for fltrAttr, or the overflow sum if the
+// cardinality limit is reached. The sum is created if it does not exist. The
+// valueMap needs to be locked by the caller.
+func (s *valueMap[N]) lookup(fltrAttr attribute.Set, t time.Time) *sumValue[N] {
+	attr := s.limit.Attributes(fltrAttr, s.values)
+	v, ok := s.values[attr.Equivalent()]
+	if !ok {
+		v = &sumValue[N]{
+			res:       s.newRes(),
+			attrs:     attr,
+			staleness: s.evict.newStaleness(t),
+		}
+		s.values[attr.Equivalent()] = v
 	}
+	return v
 }
 
 func (s *valueMap[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
@@ -41,17 +64,31 @@ func (s *valueMap[N]) measure(ctx context.Context, value N, fltrAttr attribute.S
 	s.Lock()
 	defer s.Unlock()
 
-	attr := s.limit.Attributes(fltrAttr, s.values)
-	v, ok := s.values[attr.Equivalent()]
-	if !ok {
-		v.res = s.newRes()
-	}
-
-	v.attrs = attr
+	v := s.lookup(fltrAttr, t)
 	v.n += value
+	v.update(t)
 	v.res.Offer(ctx, t, exemplar.NewValue(value), droppedAttr)
+}
 
-	s.values[attr.Equivalent()] = v
+func (s *valueMap[N]) bind(fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) BoundMeasure[N] {
+	// The cached sum is only accessed while s is locked.
+	var (
+		v   *sumValue[N]
+		gen uint64
+	)
+	return func(ctx context.Context, value N) {
+		t := now()
+
+		s.Lock()
+		defer s.Unlock()
+
+		if v == nil || gen != s.gen {
+			v, gen = s.lookup(fltrAttr, t), s.gen
+		}
+		v.n += value
+		v.update(t)
+		v.res.Offer(ctx, t, exemplar.NewValue(value), droppedAttr)
+	}
 }
 
 // newSum returns an aggregator that summarizes a set of measurements as their
@@ -99,6 +136,7 @@ func (s *sum[N]) delta(dest *metricdata.Aggregation) int {
 	}
 	// Do not report stale values.
 	clear(s.values)
+	s.gen++
 	// The delta collection cycle resets.
 	s.start = t
 
@@ -124,23 +162,28 @@ func (s *sum[N]) cumulative(dest *metricdata.Aggregation) int {
 	dPts := reset(sData.DataPoints, n, n)
 
 	var i int
-	for _, value := range s.values {
+	for key, value := range s.values {
+		if s.evict.stale(value.staleness, t) {
+			delete(s.values, key)
+			s.gen++
+			s.evict.evicted(value.attrs)
+			continue
+		}
+
 		dPts[i].Attributes = value.attrs
-		dPts[i].StartTime = s.start
+		dPts[i].StartTime = s.evict.startTime(value.staleness, s.start)
 		dPts[i].Time = t
 		dPts[i].Value = value.n
 		collectExemplars(&dPts[i].Exemplars, value.res.Collect)
-		// TODO (#3006): This will use an unbounded amount of memory if there
-		// are unbounded number of attribute sets being aggregated. Attribute
-		// sets that become "stale" need to be forgotten so this will not
-		// overload the system.
+		value.idle++
 		i++
 	}
+	s.evict.cycleStart = t
 
-	sData.DataPoints = dPts
+	sData.DataPoints = dPts[:i]
 	*dest = sData
 
-	return n
+	return i
 }
 
 // newPrecomputedSum returns an aggregator that summarizes a set of
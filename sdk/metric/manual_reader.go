@@ -26,6 +26,8 @@ type ManualReader struct {
 
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
+	cardLimit           int
+	eviction            EvictionPolicy
 }
 
 // Compile time check the manualReader implements Reader and is comparable.
@@ -37,6 +39,8 @@ func NewManualReader(opts ...ManualReaderOption) *ManualReader {
 	r := &ManualReader{
 		temporalitySelector: cfg.temporalitySelector,
 		aggregationSelector: cfg.aggregationSelector,
+		cardLimit:           cfg.cardinalityLimit,
+		eviction:            cfg.evictionPolicy,
 	}
 	r.externalProducers.Store(cfg.producers)
 	return r
@@ -46,7 +50,10 @@ func NewManualReader(opts ...ManualReaderOption) *ManualReader {
 // to read metrics from the SDK on demand.
 func (mr *ManualReader) register(p sdkProducer) {
 	// Only register once. If producer is already set, do nothing.
-	if !mr.sdkProducer.CompareAndSwap(nil, produceHolder{produce: p.produce}) {
+	if !mr.sdkProducer.CompareAndSwap(nil, produceHolder{
+		produce:           p.produce,
+		cardinalityLimits: p.cardinalityLimits,
+	}) {
 		msg := "did not register manual reader"
 		global.Error(errDuplicateRegister, msg)
 	}
@@ -62,6 +69,16 @@ func (mr *ManualReader) aggregation(kind InstrumentKind) Aggregation { // nolint
 	return mr.aggregationSelector(kind)
 }
 
+// cardinalityLimit returns the default cardinality limit of the streams.
+func (mr *ManualReader) cardinalityLimit() int {
+	return mr.cardLimit
+}
+
+// evictionPolicy returns the default eviction policy of the streams.
+func (mr *ManualReader) evictionPolicy() EvictionPolicy {
+	return mr.eviction
+}
+
 // Shutdown closes any connections and frees any resources used by the reader.
 //
 // This method is safe to call concurrently.
@@ -133,13 +150,17 @@ func (r *ManualReader) MarshalLog() interface{} {
 	down := r.isShutdown
 	r.mu.Unlock()
 	return struct {
-		Type       string
-		Registered bool
-		Shutdown   bool
+		Type              string
+		Registered        bool
+		Shutdown          bool
+		CardinalityLimit  int
+		CardinalityLimits []streamCardinalityLimit
 	}{
-		Type:       "ManualReader",
-		Registered: r.sdkProducer.Load() != nil,
-		Shutdown:   down,
+		Type:              "ManualReader",
+		Registered:        r.sdkProducer.Load() != nil,
+		Shutdown:          down,
+		CardinalityLimit:  r.cardLimit,
+		CardinalityLimits: streamCardinalityLimits(&r.sdkProducer),
 	}
 }
 
@@ -148,6 +169,8 @@ type manualReaderConfig struct {
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
 	producers           []Producer
+	cardinalityLimit    int
+	evictionPolicy      EvictionPolicy
 }
 
 // newManualReaderConfig returns a manualReaderConfig configured with options.
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
@@ -27,8 +28,9 @@ var ErrInstrumentName = errors.New("invalid instrument name")
 type meter struct {
 	embedded.Meter
 
-	scope instrumentation.Scope
-	pipes pipelines
+	scope      instrumentation.Scope
+	scopeAttrs attribute.Set
+	pipes      pipelines
 
 	int64Insts             *cacheWithErr[instID, *int64Inst]
 	float64Insts           *cacheWithErr[instID, *float64Inst]
@@ -39,7 +41,7 @@ type meter struct {
 	float64Resolver resolver[float64]
 }
 
-func newMeter(s instrumentation.Scope, p pipelines) *meter {
+func newMeter(s instrumentation.Scope, attrs attribute.Set, p pipelines) *meter {
 	// viewCache ensures instrument conflicts, including number conflicts, this
 	// meter is asked to create are logged to the user.
 	var viewCache cache[string, instID]
@@ -51,6 +53,7 @@ func newMeter(s instrumentation.Scope, p pipelines) *meter {
 
 	return &meter{
 		scope:                  s,
+		scopeAttrs:             attrs,
 		pipes:                  p,
 		int64Insts:             &int64Insts,
 		float64Insts:           &float64Insts,
@@ -61,6 +64,31 @@ func newMeter(s instrumentation.Scope, p pipelines) *meter {
 	}
 }
 
+// withAttributes returns a meter of the same instrumentation scope as m
+// configured with the instrumentation attributes attrs. The returned meter
+// creates its own instruments, which views select using attrs, but it shares
+// the view cache and aggregate functions of m. The instruments of both meters
+// are exported under the same instrumentation scope: identical streams are
+// aggregated together, and conflicting ones are logged as duplicates.
+func (m *meter) withAttributes(attrs attribute.Set) *meter {
+	var int64Insts cacheWithErr[instID, *int64Inst]
+	var float64Insts cacheWithErr[instID, *float64Inst]
+	var int64ObservableInsts cacheWithErr[instID, int64Observable]
+	var float64ObservableInsts cacheWithErr[instID, float64Observable]
+
+	return &meter{
+		scope:                  m.scope,
+		scopeAttrs:             attrs,
+		pipes:                  m.pipes,
+		int64Insts:             &int64Insts,
+		float64Insts:           &float64Insts,
+		int64ObservableInsts:   &int64ObservableInsts,
+		float64ObservableInsts: &float64ObservableInsts,
+		int64Resolver:          m.int64Resolver,
+		float64Resolver:        m.float64Resolver,
+	}
+}
+
 // Compile-time check meter implements metric.Meter.
 var _ metric.Meter = (*meter)(nil)
 
@@ -71,7 +99,8 @@ func (m *meter) Int64Counter(name string, options ...metric.Int64CounterOption)
 	cfg := metric.NewInt64CounterConfig(options...)
 	const kind = InstrumentKindCounter
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	inst, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
+	i := int64Counter{int64Inst: inst}
 	if err != nil {
 		return i, err
 	}
@@ -86,7 +115,8 @@ func (m *meter) Int64UpDownCounter(name string, options ...metric.Int64UpDownCou
 	cfg := metric.NewInt64UpDownCounterConfig(options...)
 	const kind = InstrumentKindUpDownCounter
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	inst, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
+	i := int64UpDownCounter{int64Inst: inst}
 	if err != nil {
 		return i, err
 	}
@@ -100,7 +130,8 @@ func (m *meter) Int64UpDownCounter(name string, options ...metric.Int64UpDownCou
 func (m *meter) Int64Histogram(name string, options ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
 	cfg := metric.NewInt64HistogramConfig(options...)
 	p := int64InstProvider{m}
-	i, err := p.lookupHistogram(name, cfg)
+	inst, err := p.lookupHistogram(name, cfg)
+	i := int64Histogram{int64Inst: inst}
 	if err != nil {
 		return i, err
 	}
@@ -115,7 +146,8 @@ func (m *meter) Int64Gauge(name string, options ...metric.Int64GaugeOption) (met
 	cfg := metric.NewInt64GaugeConfig(options...)
 	const kind = InstrumentKindGauge
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	inst, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
+	i := int64Gauge{int64Inst: inst}
 	if err != nil {
 		return i, err
 	}
@@ -140,7 +172,7 @@ func (m *meter) int64ObservableInstrument(id Instrument, callbacks []metric.Int6
 		for _, insert := range m.int64Resolver.inserters {
 			// Connect the measure functions for instruments in this pipeline with the
 			// callbacks for this pipeline.
-			in, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
+			in, _, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
 			if err != nil {
 				return inst, err
 			}
@@ -177,6 +209,9 @@ func (m *meter) Int64ObservableCounter(name string, options ...metric.Int64Obser
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindObservableCounter,
 		Scope:       m.scope,
+
+		scopeAttributes: m.scopeAttrs,
+		attributeKeys:   cfg.AttributeKeys(),
 	}
 	return m.int64ObservableInstrument(id, cfg.Callbacks())
 }
@@ -193,6 +228,9 @@ func (m *meter) Int64ObservableUpDownCounter(name string, options ...metric.Int6
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindObservableUpDownCounter,
 		Scope:       m.scope,
+
+		scopeAttributes: m.scopeAttrs,
+		attributeKeys:   cfg.AttributeKeys(),
 	}
 	return m.int64ObservableInstrument(id, cfg.Callbacks())
 }
@@ -209,6 +247,9 @@ func (m *meter) Int64ObservableGauge(name string, options ...metric.Int64Observa
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindObservableGauge,
 		Scope:       m.scope,
+
+		scopeAttributes: m.scopeAttrs,
+		attributeKeys:   cfg.AttributeKeys(),
 	}
 	return m.int64ObservableInstrument(id, cfg.Callbacks())
 }
@@ -220,7 +261,8 @@ func (m *meter) Float64Counter(name string, options ...metric.Float64CounterOpti
 	cfg := metric.NewFloat64CounterConfig(options...)
 	const kind = InstrumentKindCounter
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	inst, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
+	i := float64Counter{float64Inst: inst}
 	if err != nil {
 		return i, err
 	}
@@ -235,7 +277,8 @@ func (m *meter) Float64UpDownCounter(name string, options ...metric.Float64UpDow
 	cfg := metric.NewFloat64UpDownCounterConfig(options...)
 	const kind = InstrumentKindUpDownCounter
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	inst, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
+	i := float64UpDownCounter{float64Inst: inst}
 	if err != nil {
 		return i, err
 	}
@@ -249,7 +292,8 @@ func (m *meter) Float64UpDownCounter(name string, options ...metric.Float64UpDow
 func (m *meter) Float64Histogram(name string, options ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
 	cfg := metric.NewFloat64HistogramConfig(options...)
 	p := float64InstProvider{m}
-	i, err := p.lookupHistogram(name, cfg)
+	inst, err := p.lookupHistogram(name, cfg)
+	i := float64Histogram{float64Inst: inst}
 	if err != nil {
 		return i, err
 	}
@@ -264,7 +308,8 @@ func (m *meter) Float64Gauge(name string, options ...metric.Float64GaugeOption)
 	cfg := metric.NewFloat64GaugeConfig(options...)
 	const kind = InstrumentKindGauge
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	inst, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
+	i := float64Gauge{float64Inst: inst}
 	if err != nil {
 		return i, err
 	}
@@ -289,7 +334,7 @@ func (m *meter) float64ObservableInstrument(id Instrument, callbacks []metric.Fl
 		for _, insert := range m.float64Resolver.inserters {
 			// Connect the measure functions for instruments in this pipeline with the
 			// callbacks for this pipeline.
-			in, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
+			in, _, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
 			if err != nil {
 				return inst, err
 			}
@@ -326,6 +371,9 @@ func (m *meter) Float64ObservableCounter(name string, options ...metric.Float64O
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindObservableCounter,
 		Scope:       m.scope,
+
+		scopeAttributes: m.scopeAttrs,
+		attributeKeys:   cfg.AttributeKeys(),
 	}
 	return m.float64ObservableInstrument(id, cfg.Callbacks())
 }
@@ -342,6 +390,9 @@ func (m *meter) Float64ObservableUpDownCounter(name string, options ...metric.Fl
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindObservableUpDownCounter,
 		Scope:       m.scope,
+
+		scopeAttributes: m.scopeAttrs,
+		attributeKeys:   cfg.AttributeKeys(),
 	}
 	return m.float64ObservableInstrument(id, cfg.Callbacks())
 }
@@ -358,6 +409,9 @@ func (m *meter) Float64ObservableGauge(name string, options ...metric.Float64Obs
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindObservableGauge,
 		Scope:       m.scope,
+
+		scopeAttributes: m.scopeAttrs,
+		attributeKeys:   cfg.AttributeKeys(),
 	}
 	return m.float64ObservableInstrument(id, cfg.Callbacks())
 }
@@ -575,18 +629,21 @@ func (noopRegister) Unregister() error {
 // int64InstProvider provides int64 OpenTelemetry instruments.
 type int64InstProvider struct{ *meter }
 
-func (p int64InstProvider) aggs(kind InstrumentKind, name, desc, u string) ([]aggregate.Measure[int64], error) {
+func (p int64InstProvider) aggs(kind InstrumentKind, name, desc, u string, keys []attribute.Key) ([]aggregate.Measure[int64], []aggregate.Bind[int64], error) {
 	inst := Instrument{
 		Name:        name,
 		Description: desc,
 		Unit:        u,
 		Kind:        kind,
 		Scope:       p.scope,
+
+		scopeAttributes: p.scopeAttrs,
+		attributeKeys:   keys,
 	}
 	return p.int64Resolver.Aggregators(inst)
 }
 
-func (p int64InstProvider) histogramAggs(name string, cfg metric.Int64HistogramConfig) ([]aggregate.Measure[int64], error) {
+func (p int64InstProvider) histogramAggs(name string, cfg metric.Int64HistogramConfig) ([]aggregate.Measure[int64], []aggregate.Bind[int64], error) {
 	boundaries := cfg.ExplicitBucketBoundaries()
 	aggError := AggregationExplicitBucketHistogram{Boundaries: boundaries}.err()
 	if aggError != nil {
@@ -599,21 +656,24 @@ func (p int64InstProvider) histogramAggs(name string, cfg metric.Int64HistogramC
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindHistogram,
 		Scope:       p.scope,
+
+		scopeAttributes: p.scopeAttrs,
+		attributeKeys:   cfg.AttributeKeys(),
 	}
-	measures, err := p.int64Resolver.HistogramAggregators(inst, boundaries)
-	return measures, errors.Join(aggError, err)
+	measures, binds, err := p.int64Resolver.HistogramAggregators(inst, boundaries)
+	return measures, binds, errors.Join(aggError, err)
 }
 
 // lookup returns the resolved instrumentImpl.
-func (p int64InstProvider) lookup(kind InstrumentKind, name, desc, u string) (*int64Inst, error) {
+func (p int64InstProvider) lookup(kind InstrumentKind, name, desc, u string, keys []attribute.Key) (*int64Inst, error) {
 	return p.meter.int64Insts.Lookup(instID{
 		Name:        name,
 		Description: desc,
 		Unit:        u,
 		Kind:        kind,
 	}, func() (*int64Inst, error) {
-		aggs, err := p.aggs(kind, name, desc, u)
-		return &int64Inst{measures: aggs}, err
+		aggs, binds, err := p.aggs(kind, name, desc, u, keys)
+		return &int64Inst{measures: aggs, binds: binds}, err
 	})
 }
 
@@ -625,26 +685,29 @@ func (p int64InstProvider) lookupHistogram(name string, cfg metric.Int64Histogra
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindHistogram,
 	}, func() (*int64Inst, error) {
-		aggs, err := p.histogramAggs(name, cfg)
-		return &int64Inst{measures: aggs}, err
+		aggs, binds, err := p.histogramAggs(name, cfg)
+		return &int64Inst{measures: aggs, binds: binds}, err
 	})
 }
 
 // float64InstProvider provides float64 OpenTelemetry instruments.
 type float64InstProvider struct{ *meter }
 
-func (p float64InstProvider) aggs(kind InstrumentKind, name, desc, u string) ([]aggregate.Measure[float64], error) {
+func (p float64InstProvider) aggs(kind InstrumentKind, name, desc, u string, keys []attribute.Key) ([]aggregate.Measure[float64], []aggregate.Bind[float64], error) {
 	inst := Instrument{
 		Name:        name,
 		Description: desc,
 		Unit:        u,
 		Kind:        kind,
 		Scope:       p.scope,
+
+		scopeAttributes: p.scopeAttrs,
+		attributeKeys:   keys,
 	}
 	return p.float64Resolver.Aggregators(inst)
 }
 
-func (p float64InstProvider) histogramAggs(name string, cfg metric.Float64HistogramConfig) ([]aggregate.Measure[float64], error) {
+func (p float64InstProvider) histogramAggs(name string, cfg metric.Float64HistogramConfig) ([]aggregate.Measure[float64], []aggregate.Bind[float64], error) {
 	boundaries := cfg.ExplicitBucketBoundaries()
 	aggError := AggregationExplicitBucketHistogram{Boundaries: boundaries}.err()
 	if aggError != nil {
@@ -657,21 +720,24 @@ func (p float64InstProvider) histogramAggs(name string, cfg metric.Float64Histog
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindHistogram,
 		Scope:       p.scope,
+
+		scopeAttributes: p.scopeAttrs,
+		attributeKeys:   cfg.AttributeKeys(),
 	}
-	measures, err := p.float64Resolver.HistogramAggregators(inst, boundaries)
-	return measures, errors.Join(aggError, err)
+	measures, binds, err := p.float64Resolver.HistogramAggregators(inst, boundaries)
+	return measures, binds, errors.Join(aggError, err)
 }
 
 // lookup returns the resolved instrumentImpl.
-func (p float64InstProvider) lookup(kind InstrumentKind, name, desc, u string) (*float64Inst, error) {
+func (p float64InstProvider) lookup(kind InstrumentKind, name, desc, u string, keys []attribute.Key) (*float64Inst, error) {
 	return p.meter.float64Insts.Lookup(instID{
 		Name:        name,
 		Description: desc,
 		Unit:        u,
 		Kind:        kind,
 	}, func() (*float64Inst, error) {
-		aggs, err := p.aggs(kind, name, desc, u)
-		return &float64Inst{measures: aggs}, err
+		aggs, binds, err := p.aggs(kind, name, desc, u, keys)
+		return &float64Inst{measures: aggs, binds: binds}, err
 	})
 }
 
@@ -683,8 +749,8 @@ func (p float64InstProvider) lookupHistogram(name string, cfg metric.Float64Hist
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindHistogram,
 	}, func() (*float64Inst, error) {
-		aggs, err := p.histogramAggs(name, cfg)
-		return &float64Inst{measures: aggs}, err
+		aggs, binds, err := p.histogramAggs(name, cfg)
+		return &float64Inst{measures: aggs, binds: binds}, err
 	})
 }
 
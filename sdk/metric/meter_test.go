@@ -2113,6 +2113,127 @@ func TestHistogramBucketPrecedenceOrdering(t *testing.T) {
 	}
 }
 
+func TestAttributeAdvice(t *testing.T) {
+	attrs := attribute.NewSet(
+		attribute.String("user", "Alice"),
+		attribute.Bool("admin", true),
+		attribute.Int("id", 1),
+	)
+
+	// Each instrument is created with the advisory attribute keys, measured
+	// with attrs, and returns the attributes of the collected data point.
+	instruments := map[string]func(t *testing.T, m metric.Meter, keys []attribute.Key) func(metricdata.Aggregation) attribute.Set{
+		"Int64Counter": func(t *testing.T, m metric.Meter, keys []attribute.Key) func(metricdata.Aggregation) attribute.Set {
+			var opts []metric.Int64CounterOption
+			if keys != nil {
+				opts = append(opts, metric.WithAttributeKeys(keys...))
+			}
+			ctr, err := m.Int64Counter("inst", opts...)
+			require.NoError(t, err)
+			ctr.Add(context.Background(), 1, metric.WithAttributeSet(attrs))
+			return func(agg metricdata.Aggregation) attribute.Set {
+				require.IsType(t, metricdata.Sum[int64]{}, agg)
+				dPts := agg.(metricdata.Sum[int64]).DataPoints
+				require.Len(t, dPts, 1)
+				return dPts[0].Attributes
+			}
+		},
+		"Float64Histogram": func(t *testing.T, m metric.Meter, keys []attribute.Key) func(metricdata.Aggregation) attribute.Set {
+			var opts []metric.Float64HistogramOption
+			if keys != nil {
+				opts = append(opts, metric.WithAttributeKeys(keys...))
+			}
+			hist, err := m.Float64Histogram("inst", opts...)
+			require.NoError(t, err)
+			hist.Record(context.Background(), 1, metric.WithAttributeSet(attrs))
+			return func(agg metricdata.Aggregation) attribute.Set {
+				require.IsType(t, metricdata.Histogram[float64]{}, agg)
+				dPts := agg.(metricdata.Histogram[float64]).DataPoints
+				require.Len(t, dPts, 1)
+				return dPts[0].Attributes
+			}
+		},
+		"Int64ObservableGauge": func(t *testing.T, m metric.Meter, keys []attribute.Key) func(metricdata.Aggregation) attribute.Set {
+			opts := []metric.Int64ObservableGaugeOption{
+				metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+					o.Observe(1, metric.WithAttributeSet(attrs))
+					return nil
+				}),
+			}
+			if keys != nil {
+				opts = append(opts, metric.WithAttributeKeys(keys...))
+			}
+			_, err := m.Int64ObservableGauge("inst", opts...)
+			require.NoError(t, err)
+			return func(agg metricdata.Aggregation) attribute.Set {
+				require.IsType(t, metricdata.Gauge[int64]{}, agg)
+				dPts := agg.(metricdata.Gauge[int64]).DataPoints
+				require.Len(t, dPts, 1)
+				return dPts[0].Attributes
+			}
+		},
+	}
+
+	for _, tt := range []struct {
+		desc  string
+		views []View
+		keys  []attribute.Key
+		want  attribute.Set
+	}{
+		{
+			desc: "NoAdvice",
+			want: attrs,
+		},
+		{
+			desc: "Advice",
+			keys: []attribute.Key{"user", "admin"},
+			want: attribute.NewSet(
+				attribute.String("user", "Alice"),
+				attribute.Bool("admin", true),
+			),
+		},
+		{
+			desc: "EmptyAdvice",
+			keys: []attribute.Key{},
+			want: *attribute.EmptySet(),
+		},
+		{
+			desc: "ViewWithoutFilter",
+			views: []View{NewView(Instrument{Name: "inst"}, Stream{
+				Description: "view without attribute filter",
+			})},
+			keys: []attribute.Key{"user"},
+			want: attribute.NewSet(attribute.String("user", "Alice")),
+		},
+		{
+			desc: "OverriddenByView",
+			views: []View{NewView(Instrument{Name: "inst"}, Stream{
+				AttributeFilter: attribute.NewAllowKeysFilter("id"),
+			})},
+			keys: []attribute.Key{"user"},
+			want: attribute.NewSet(attribute.Int("id", 1)),
+		},
+	} {
+		for name, newInst := range instruments {
+			t.Run(tt.desc+"/"+name, func(t *testing.T) {
+				rdr := NewManualReader()
+				m := NewMeterProvider(
+					WithReader(rdr),
+					WithView(tt.views...),
+				).Meter("TestAttributeAdvice")
+				attributes := newInst(t, m, tt.keys)
+
+				var rm metricdata.ResourceMetrics
+				require.NoError(t, rdr.Collect(context.Background(), &rm))
+				require.Len(t, rm.ScopeMetrics, 1)
+				require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+				got := attributes(rm.ScopeMetrics[0].Metrics[0].Data)
+				assert.Equal(t, tt.want, got)
+			})
+		}
+	}
+}
+
 func TestObservableDropAggregation(t *testing.T) {
 	const (
 		intPrefix         = "observable.int64."
@@ -2427,3 +2548,303 @@ func TestDuplicateInstrumentCreation(t *testing.T) {
 		})
 	}
 }
+
+func TestBoundInstruments(t *testing.T) {
+	alice := attribute.NewSet(attribute.String("user", "Alice"), attribute.Bool("admin", true))
+	bob := attribute.NewSet(attribute.String("user", "Bob"), attribute.Bool("admin", false))
+
+	type measureF func(ctx context.Context, v int64, attrs attribute.Set)
+	// Each instrument returns a function measuring unbound, and a function
+	// binding then measuring.
+	instruments := map[string]func(metric.Meter) (measureF, func(attribute.Set) func(context.Context, int64)){
+		"Int64Counter": func(m metric.Meter) (measureF, func(attribute.Set) func(context.Context, int64)) {
+			i, err := m.Int64Counter("inst")
+			require.NoError(t, err)
+			return func(ctx context.Context, v int64, s attribute.Set) {
+					i.Add(ctx, v, metric.WithAttributeSet(s))
+				}, func(s attribute.Set) func(context.Context, int64) {
+					return i.Bind(s).Add
+				}
+		},
+		"Int64UpDownCounter": func(m metric.Meter) (measureF, func(attribute.Set) func(context.Context, int64)) {
+			i, err := m.Int64UpDownCounter("inst")
+			require.NoError(t, err)
+			return func(ctx context.Context, v int64, s attribute.Set) {
+					i.Add(ctx, v, metric.WithAttributeSet(s))
+				}, func(s attribute.Set) func(context.Context, int64) {
+					return i.Bind(s).Add
+				}
+		},
+		"Int64Histogram": func(m metric.Meter) (measureF, func(attribute.Set) func(context.Context, int64)) {
+			i, err := m.Int64Histogram("inst")
+			require.NoError(t, err)
+			return func(ctx context.Context, v int64, s attribute.Set) {
+					i.Record(ctx, v, metric.WithAttributeSet(s))
+				}, func(s attribute.Set) func(context.Context, int64) {
+					return i.Bind(s).Record
+				}
+		},
+		"Int64Gauge": func(m metric.Meter) (measureF, func(attribute.Set) func(context.Context, int64)) {
+			i, err := m.Int64Gauge("inst")
+			require.NoError(t, err)
+			return func(ctx context.Context, v int64, s attribute.Set) {
+					i.Record(ctx, v, metric.WithAttributeSet(s))
+				}, func(s attribute.Set) func(context.Context, int64) {
+					return i.Bind(s).Record
+				}
+		},
+		"Float64Counter": func(m metric.Meter) (measureF, func(attribute.Set) func(context.Context, int64)) {
+			i, err := m.Float64Counter("inst")
+			require.NoError(t, err)
+			return func(ctx context.Context, v int64, s attribute.Set) {
+					i.Add(ctx, float64(v), metric.WithAttributeSet(s))
+				}, func(s attribute.Set) func(context.Context, int64) {
+					b := i.Bind(s)
+					return func(ctx context.Context, v int64) { b.Add(ctx, float64(v)) }
+				}
+		},
+		"Float64UpDownCounter": func(m metric.Meter) (measureF, func(attribute.Set) func(context.Context, int64)) {
+			i, err := m.Float64UpDownCounter("inst")
+			require.NoError(t, err)
+			return func(ctx context.Context, v int64, s attribute.Set) {
+					i.Add(ctx, float64(v), metric.WithAttributeSet(s))
+				}, func(s attribute.Set) func(context.Context, int64) {
+					b := i.Bind(s)
+					return func(ctx context.Context, v int64) { b.Add(ctx, float64(v)) }
+				}
+		},
+		"Float64Histogram": func(m metric.Meter) (measureF, func(attribute.Set) func(context.Context, int64)) {
+			i, err := m.Float64Histogram("inst")
+			require.NoError(t, err)
+			return func(ctx context.Context, v int64, s attribute.Set) {
+					i.Record(ctx, float64(v), metric.WithAttributeSet(s))
+				}, func(s attribute.Set) func(context.Context, int64) {
+					b := i.Bind(s)
+					return func(ctx context.Context, v int64) { b.Record(ctx, float64(v)) }
+				}
+		},
+		"Float64Gauge": func(m metric.Meter) (measureF, func(attribute.Set) func(context.Context, int64)) {
+			i, err := m.Float64Gauge("inst")
+			require.NoError(t, err)
+			return func(ctx context.Context, v int64, s attribute.Set) {
+					i.Record(ctx, float64(v), metric.WithAttributeSet(s))
+				}, func(s attribute.Set) func(context.Context, int64) {
+					b := i.Bind(s)
+					return func(ctx context.Context, v int64) { b.Record(ctx, float64(v)) }
+				}
+		},
+	}
+
+	views := []View{
+		NewView(Instrument{Name: "inst"}, Stream{}),
+		NewView(Instrument{Name: "inst"}, Stream{
+			Name:            "inst.filtered",
+			AttributeFilter: attribute.NewAllowKeysFilter("user"),
+		}),
+		NewView(Instrument{Name: "inst"}, Stream{
+			Name:        "inst.dropped",
+			Aggregation: AggregationDrop{},
+		}),
+	}
+	selectors := map[string]TemporalitySelector{
+		"Cumulative": cumulativeTemporalitySelector,
+		"Delta":      deltaTemporalitySelector,
+	}
+
+	ctx := context.Background()
+	for name, newInst := range instruments {
+		for sName, selector := range selectors {
+			t.Run(name+"/"+sName, func(t *testing.T) {
+				wantRdr := NewManualReader(WithTemporalitySelector(selector))
+				wantMeas, _ := newInst(NewMeterProvider(
+					WithReader(wantRdr),
+					WithView(views...),
+				).Meter("TestBoundInstruments"))
+
+				gotRdr := NewManualReader(WithTemporalitySelector(selector))
+				_, bind := newInst(NewMeterProvider(
+					WithReader(gotRdr),
+					WithView(views...),
+				).Meter("TestBoundInstruments"))
+				boundAlice, boundBob := bind(alice), bind(bob)
+
+				assertEqual := func() metricdata.ResourceMetrics {
+					t.Helper()
+					var want, got metricdata.ResourceMetrics
+					require.NoError(t, wantRdr.Collect(ctx, &want))
+					require.NoError(t, gotRdr.Collect(ctx, &got))
+					metricdatatest.AssertEqual(t, want, got, metricdatatest.IgnoreTimestamp())
+					return got
+				}
+
+				wantMeas(ctx, 1, alice)
+				wantMeas(ctx, 2, bob)
+				boundAlice(ctx, 1)
+				boundBob(ctx, 2)
+				got := assertEqual()
+				require.Len(t, got.ScopeMetrics, 1)
+				assert.Len(t, got.ScopeMetrics[0].Metrics, 2, "inst and inst.filtered")
+
+				wantMeas(ctx, 3, alice)
+				boundAlice(ctx, 3)
+				assertEqual()
+
+				assertEqual()
+
+				wantMeas(ctx, 4, bob)
+				boundBob(ctx, 4)
+				assertEqual()
+			})
+		}
+	}
+}
+
+func TestNewViewsScopeAttributesSameScope(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(rdr),
+		WithView(NewViews(
+			Selector{
+				Instrument:      Instrument{Name: "storage.ops"},
+				ScopeAttributes: attribute.NewSet(attribute.String("team", "storage")),
+			},
+			Stream{Name: "storage.ops.storage"},
+		)...),
+	)
+
+	// Meters of the same scope with different attributes are distinct, the
+	// selector only matches the instruments of the storage Meter. The
+	// instruments with the same identity are aggregated into a single metric
+	// of the scope.
+	ctx := context.Background()
+	for _, team := range []string{"network", "storage"} {
+		m := mp.Meter("shared", metric.WithInstrumentationAttributes(attribute.String("team", team)))
+		for _, name := range []string{"requests", "storage.ops"} {
+			ctr, err := m.Int64Counter(name)
+			require.NoError(t, err)
+			ctr.Add(ctx, 1)
+		}
+	}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	sum := func(name string, v int64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name: name,
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: v}},
+			},
+		}
+	}
+	metricdatatest.AssertEqual(t, metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: "shared"},
+		Metrics: []metricdata.Metrics{
+			sum("requests", 2),
+			sum("storage.ops", 1),
+			sum("storage.ops.storage", 1),
+		},
+	}, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestScopeAttributesConflictingInstruments(t *testing.T) {
+	var msg string
+	otel.SetLogger(funcr.New(func(_, args string) {
+		msg = args
+	}, funcr.Options{Verbosity: 20}))
+	t.Cleanup(func() { otel.SetLogger(logr.Discard()) })
+
+	mp := NewMeterProvider(WithReader(NewManualReader()))
+	network := mp.Meter("shared", metric.WithInstrumentationAttributes(attribute.String("team", "network")))
+	storage := mp.Meter("shared", metric.WithInstrumentationAttributes(attribute.String("team", "storage")))
+	msg = ""
+
+	_, err := network.Int64Counter("requests", metric.WithUnit("{request}"))
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+
+	_, err = storage.Int64Counter("requests", metric.WithUnit("By"))
+	require.NoError(t, err)
+	assert.Contains(t, msg, "duplicate metric stream definitions", "conflict across the Meters of a scope should be logged")
+}
+
+func TestNewViewsMultipleStreams(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(rdr),
+		WithView(NewViews(
+			Selector{
+				Instrument:      Instrument{Name: "latency"},
+				ScopeAttributes: attribute.NewSet(attribute.String("team", "storage")),
+			},
+			Stream{Aggregation: AggregationExplicitBucketHistogram{Boundaries: []float64{10}}},
+			Stream{Name: "latency.sum", Aggregation: AggregationSum{}},
+		)...),
+	)
+
+	ctx := context.Background()
+	for _, name := range []string{"storage", "network"} {
+		m := mp.Meter(name, metric.WithInstrumentationAttributes(
+			attribute.String("team", name),
+		))
+		hist, err := m.Int64Histogram("latency")
+		require.NoError(t, err)
+		hist.Record(ctx, 5)
+		hist.Record(ctx, 15)
+	}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 2)
+
+	want := map[string][]metricdata.Metrics{
+		"storage": {
+			{
+				Name: "latency",
+				Data: metricdata.Histogram[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.HistogramDataPoint[int64]{{
+						Count:        2,
+						Bounds:       []float64{10},
+						BucketCounts: []uint64{1, 1},
+						Min:          metricdata.NewExtrema[int64](5),
+						Max:          metricdata.NewExtrema[int64](15),
+						Sum:          20,
+					}},
+				},
+			},
+			{
+				Name: "latency.sum",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints:  []metricdata.DataPoint[int64]{{Value: 20}},
+				},
+			},
+		},
+		"network": {
+			{
+				Name: "latency",
+				Data: metricdata.Histogram[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.HistogramDataPoint[int64]{{
+						Count:        2,
+						Bounds:       []float64{0, 5, 10, 25, 50, 75, 100, 250, 500, 750, 1000, 2500, 5000, 7500, 10000},
+						BucketCounts: []uint64{0, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+						Min:          metricdata.NewExtrema[int64](5),
+						Max:          metricdata.NewExtrema[int64](15),
+						Sum:          20,
+					}},
+				},
+			},
+		},
+	}
+	for _, sm := range rm.ScopeMetrics {
+		metricdatatest.AssertEqual(t, metricdata.ScopeMetrics{
+			Scope:   sm.Scope,
+			Metrics: want[sm.Scope.Name],
+		}, sm, metricdatatest.IgnoreTimestamp())
+	}
+}
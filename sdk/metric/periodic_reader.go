@@ -24,9 +24,11 @@ const (
 
 // periodicReaderConfig contains configuration options for a PeriodicReader.
 type periodicReaderConfig struct {
-	interval  time.Duration
-	timeout   time.Duration
-	producers []Producer
+	interval         time.Duration
+	timeout          time.Duration
+	producers        []Producer
+	cardinalityLimit int
+	evictionPolicy   EvictionPolicy
 }
 
 // newPeriodicReaderConfig returns a periodicReaderConfig configured with
@@ -118,6 +120,8 @@ func NewPeriodicReader(exporter Exporter, options ...PeriodicReaderOption) *Peri
 				return &metricdata.ResourceMetrics{}
 			},
 		},
+		cardLimit: conf.cardinalityLimit,
+		eviction:  conf.evictionPolicy,
 	}
 	r.externalProducers.Store(conf.producers)
 
@@ -143,6 +147,9 @@ type PeriodicReader struct {
 	exporter Exporter
 	flushCh  chan chan error
 
+	cardLimit int
+	eviction  EvictionPolicy
+
 	done         chan struct{}
 	cancel       context.CancelFunc
 	shutdownOnce sync.Once
@@ -181,7 +188,10 @@ func (r *PeriodicReader) run(ctx context.Context, interval time.Duration) {
 // register registers p as the producer of this reader.
 func (r *PeriodicReader) register(p sdkProducer) {
 	// Only register once. If producer is already set, do nothing.
-	if !r.sdkProducer.CompareAndSwap(nil, produceHolder{produce: p.produce}) {
+	if !r.sdkProducer.CompareAndSwap(nil, produceHolder{
+		produce:           p.produce,
+		cardinalityLimits: p.cardinalityLimits,
+	}) {
 		msg := "did not register periodic reader"
 		global.Error(errDuplicateRegister, msg)
 	}
@@ -197,6 +207,16 @@ func (r *PeriodicReader) aggregation(kind InstrumentKind) Aggregation { // nolin
 	return r.exporter.Aggregation(kind)
 }
 
+// cardinalityLimit returns the default cardinality limit of the streams.
+func (r *PeriodicReader) cardinalityLimit() int {
+	return r.cardLimit
+}
+
+// evictionPolicy returns the default eviction policy of the streams.
+func (r *PeriodicReader) evictionPolicy() EvictionPolicy {
+	return r.eviction
+}
+
 // collectAndExport gather all metric data related to the periodicReader r from
 // the SDK and exports it with r's exporter.
 func (r *PeriodicReader) collectAndExport(ctx context.Context) error {
@@ -353,18 +373,22 @@ func (r *PeriodicReader) MarshalLog() interface{} {
 	down := r.isShutdown
 	r.mu.Unlock()
 	return struct {
-		Type       string
-		Exporter   Exporter
-		Registered bool
-		Shutdown   bool
-		Interval   time.Duration
-		Timeout    time.Duration
+		Type              string
+		Exporter          Exporter
+		Registered        bool
+		Shutdown          bool
+		Interval          time.Duration
+		Timeout           time.Duration
+		CardinalityLimit  int
+		CardinalityLimits []streamCardinalityLimit
 	}{
-		Type:       "PeriodicReader",
-		Exporter:   r.exporter,
-		Registered: r.sdkProducer.Load() != nil,
-		Shutdown:   down,
-		Interval:   r.interval,
-		Timeout:    r.timeout,
+		Type:              "PeriodicReader",
+		Exporter:          r.exporter,
+		Registered:        r.sdkProducer.Load() != nil,
+		Shutdown:          down,
+		Interval:          r.interval,
+		Timeout:           r.timeout,
+		CardinalityLimit:  r.cardLimit,
+		CardinalityLimits: streamCardinalityLimits(&r.sdkProducer),
 	}
 }
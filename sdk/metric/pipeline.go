@@ -8,10 +8,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
@@ -32,10 +34,11 @@ var (
 // instrumentSync is a synchronization point between a pipeline and an
 // instrument's aggregate function.
 type instrumentSync struct {
-	name        string
-	description string
-	unit        string
-	compAgg     aggregate.ComputeAggregation
+	name             string
+	description      string
+	unit             string
+	cardinalityLimit int
+	compAgg          aggregate.ComputeAggregation
 }
 
 func newPipeline(res *resource.Resource, reader Reader, views []View) *pipeline {
@@ -160,6 +163,31 @@ func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics)
 	return errs.errorOrNil()
 }
 
+// cardinalityLimits returns the cardinality limit of each stream of the
+// pipeline, sorted by instrumentation scope name and stream name.
+func (p *pipeline) cardinalityLimits() []streamCardinalityLimit {
+	p.Lock()
+	defer p.Unlock()
+
+	var limits []streamCardinalityLimit
+	for scope, instruments := range p.aggregations {
+		for _, inst := range instruments {
+			limits = append(limits, streamCardinalityLimit{
+				Scope: scope.Name,
+				Name:  inst.name,
+				Limit: inst.cardinalityLimit,
+			})
+		}
+	}
+	sort.Slice(limits, func(i, j int) bool {
+		if limits[i].Scope != limits[j].Scope {
+			return limits[i].Scope < limits[j].Scope
+		}
+		return limits[i].Name < limits[j].Name
+	})
+	return limits
+}
+
 // inserter facilitates inserting of new instruments from a single scope into a
 // pipeline.
 type inserter[N int64 | float64] struct {
@@ -194,7 +222,9 @@ func newInserter[N int64 | float64](p *pipeline, vc *cache[string, instID]) *ins
 // Instrument inserts the instrument inst with instUnit into a pipeline. All
 // views the pipeline contains are matched against, and any matching view that
 // creates a unique aggregate function will have its output inserted into the
-// pipeline and its input included in the returned slice.
+// pipeline and its input included in the returned slice. The input binders of
+// those aggregate functions that support binding are returned in a second
+// slice.
 //
 // The returned aggregate function inputs are ensured to be deduplicated and
 // unique. If another view in another pipeline that is cached by this
@@ -213,10 +243,11 @@ func newInserter[N int64 | float64](p *pipeline, vc *cache[string, instID]) *ins
 //
 // If an instrument is determined to use a Drop aggregation, that instrument is
 // not inserted nor returned.
-func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation) ([]aggregate.Measure[N], error) {
+func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation) ([]aggregate.Measure[N], []aggregate.Bind[N], error) {
 	var (
 		matched  bool
 		measures []aggregate.Measure[N]
+		binds    []aggregate.Bind[N]
 	)
 
 	errs := &multierror{wrapped: errCreatingAggregators}
@@ -227,7 +258,8 @@ func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation)
 			continue
 		}
 		matched = true
-		in, id, err := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
+		adviseAttributes(inst, &stream)
+		in, bind, id, err := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
 		if err != nil {
 			errs.append(err)
 		}
@@ -240,10 +272,13 @@ func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation)
 		}
 		seen[id] = struct{}{}
 		measures = append(measures, in)
+		if bind != nil {
+			binds = append(binds, bind)
+		}
 	}
 
 	if matched {
-		return measures, errs.errorOrNil()
+		return measures, binds, errs.errorOrNil()
 	}
 
 	// Apply implicit default view if no explicit matched.
@@ -252,7 +287,8 @@ func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation)
 		Description: inst.Description,
 		Unit:        inst.Unit,
 	}
-	in, _, err := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
+	adviseAttributes(inst, &stream)
+	in, bind, _, err := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
 	if err != nil {
 		errs.append(err)
 	}
@@ -260,7 +296,20 @@ func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation)
 		// Ensured to have not seen given matched was false.
 		measures = append(measures, in)
 	}
-	return measures, errs.errorOrNil()
+	if bind != nil {
+		binds = append(binds, bind)
+	}
+	return measures, binds, errs.errorOrNil()
+}
+
+// adviseAttributes sets the AttributeFilter of stream to only keep the
+// advisory attribute keys of inst. The AttributeFilter set by a view takes
+// precedence over the advice of the instrument.
+func adviseAttributes(inst Instrument, stream *Stream) {
+	if stream.AttributeFilter != nil || inst.attributeKeys == nil {
+		return
+	}
+	stream.AttributeFilter = attribute.NewAllowKeysFilter(inst.attributeKeys...)
 }
 
 // addCallback registers a single instrument callback to be run when
@@ -277,6 +326,7 @@ var aggIDCount uint64
 type aggVal[N int64 | float64] struct {
 	ID      uint64
 	Measure aggregate.Measure[N]
+	Bind    aggregate.Bind[N]
 	Err     error
 }
 
@@ -305,11 +355,11 @@ func (i *inserter[N]) readerDefaultAggregation(kind InstrumentKind) Aggregation
 	return aggregation
 }
 
-// cachedAggregator returns the appropriate aggregate input and output
-// functions for an instrument configuration. If the exact instrument has been
-// created within the inst.Scope, those aggregate function instances will be
-// returned. Otherwise, new computed aggregate functions will be cached and
-// returned.
+// cachedAggregator returns the appropriate aggregate input, input binder, and
+// output functions for an instrument configuration. If the exact instrument
+// has been created within the inst.Scope, those aggregate function instances
+// will be returned. Otherwise, new computed aggregate functions will be cached
+// and returned.
 //
 // If the instrument configuration conflicts with an instrument that has
 // already been created (e.g. description, unit, data type) a warning will be
@@ -319,7 +369,7 @@ func (i *inserter[N]) readerDefaultAggregation(kind InstrumentKind) Aggregation
 //
 // If the instrument defines an unknown or incompatible aggregation, an error
 // is returned.
-func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind InstrumentKind, stream Stream, readerAggregation Aggregation) (meas aggregate.Measure[N], aggID uint64, err error) {
+func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind InstrumentKind, stream Stream, readerAggregation Aggregation) (meas aggregate.Measure[N], bind aggregate.Bind[N], aggID uint64, err error) {
 	switch stream.Aggregation.(type) {
 	case nil:
 		// The aggregation was not overridden with a view. Use the aggregation
@@ -331,7 +381,7 @@ func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind Instrum
 	}
 
 	if err := isAggregatorCompatible(kind, stream.Aggregation); err != nil {
-		return nil, 0, fmt.Errorf(
+		return nil, nil, 0, fmt.Errorf(
 			"creating aggregator with instrumentKind: %d, aggregation %v: %w",
 			kind, stream.Aggregation, err,
 		)
@@ -354,29 +404,55 @@ func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind Instrum
 		b.Filter = stream.AttributeFilter
 		// A value less than or equal to zero will disable the aggregation
 		// limits for the builder (an all the created aggregates).
-		// CardinalityLimit.Lookup returns 0 by default if unset (or
-		// unrecognized input). Use that value directly.
-		b.AggregationLimit, _ = x.CardinalityLimit.Lookup()
+		b.AggregationLimit = i.cardinalityLimit(stream)
+		b.Eviction = i.evictionPolicy(stream).eviction(stream.Name)
 
-		in, out, err := i.aggregateFunc(b, stream.Aggregation, kind)
+		in, out, bind, err := i.aggregateFunc(b, stream.Aggregation, kind)
 		if err != nil {
-			return aggVal[N]{0, nil, err}
+			return aggVal[N]{0, nil, nil, err}
 		}
 		if in == nil { // Drop aggregator.
-			return aggVal[N]{0, nil, nil}
+			return aggVal[N]{0, nil, nil, nil}
 		}
 		i.pipeline.addSync(scope, instrumentSync{
 			// Use the first-seen name casing for this and all subsequent
 			// requests of this instrument.
-			name:        stream.Name,
-			description: stream.Description,
-			unit:        stream.Unit,
-			compAgg:     out,
+			name:             stream.Name,
+			description:      stream.Description,
+			unit:             stream.Unit,
+			cardinalityLimit: b.AggregationLimit,
+			compAgg:          out,
 		})
 		id := atomic.AddUint64(&aggIDCount, 1)
-		return aggVal[N]{id, in, err}
+		return aggVal[N]{id, in, bind, err}
 	})
-	return cv.Measure, cv.ID, cv.Err
+	return cv.Measure, cv.Bind, cv.ID, cv.Err
+}
+
+// cardinalityLimit returns the cardinality limit of stream. The limit of the
+// stream takes precedence over the default limit of the reader, which takes
+// precedence over the limit of the OTEL_GO_X_CARDINALITY_LIMIT environment
+// variable. A value less than or equal to zero means no limit.
+func (i *inserter[N]) cardinalityLimit(stream Stream) int {
+	if stream.CardinalityLimit != 0 {
+		return stream.CardinalityLimit
+	}
+	if limit := i.pipeline.reader.cardinalityLimit(); limit != 0 {
+		return limit
+	}
+	// CardinalityLimit.Lookup returns 0 by default if unset (or unrecognized
+	// input). Use that value directly.
+	limit, _ := x.CardinalityLimit.Lookup()
+	return limit
+}
+
+// evictionPolicy returns the eviction policy of stream. The policy of the
+// stream takes precedence over the default policy of the reader.
+func (i *inserter[N]) evictionPolicy(stream Stream) EvictionPolicy {
+	if stream.EvictionPolicy.enabled() {
+		return stream.EvictionPolicy
+	}
+	return i.pipeline.reader.evictionPolicy()
 }
 
 // logConflict validates if an instrument with the same case-insensitive name
@@ -440,7 +516,7 @@ func (i *inserter[N]) instID(kind InstrumentKind, stream Stream) instID {
 // aggregateFunc returns new aggregate functions matching agg, kind, and
 // monotonic. If the agg is unknown or temporality is invalid, an error is
 // returned.
-func (i *inserter[N]) aggregateFunc(b aggregate.Builder[N], agg Aggregation, kind InstrumentKind) (meas aggregate.Measure[N], comp aggregate.ComputeAggregation, err error) {
+func (i *inserter[N]) aggregateFunc(b aggregate.Builder[N], agg Aggregation, kind InstrumentKind) (meas aggregate.Measure[N], comp aggregate.ComputeAggregation, bind aggregate.Bind[N], err error) {
 	switch a := agg.(type) {
 	case AggregationDefault:
 		return i.aggregateFunc(b, DefaultAggregationSelector(kind), kind)
@@ -449,22 +525,22 @@ func (i *inserter[N]) aggregateFunc(b aggregate.Builder[N], agg Aggregation, kin
 	case AggregationLastValue:
 		switch kind {
 		case InstrumentKindGauge:
-			meas, comp = b.LastValue()
+			meas, comp, bind = b.LastValue()
 		case InstrumentKindObservableGauge:
-			meas, comp = b.PrecomputedLastValue()
+			meas, comp, bind = b.PrecomputedLastValue()
 		}
 	case AggregationSum:
 		switch kind {
 		case InstrumentKindObservableCounter:
-			meas, comp = b.PrecomputedSum(true)
+			meas, comp, bind = b.PrecomputedSum(true)
 		case InstrumentKindObservableUpDownCounter:
-			meas, comp = b.PrecomputedSum(false)
+			meas, comp, bind = b.PrecomputedSum(false)
 		case InstrumentKindCounter, InstrumentKindHistogram:
-			meas, comp = b.Sum(true)
+			meas, comp, bind = b.Sum(true)
 		default:
 			// InstrumentKindUpDownCounter, InstrumentKindObservableGauge, and
 			// instrumentKindUndefined or other invalid instrument kinds.
-			meas, comp = b.Sum(false)
+			meas, comp, bind = b.Sum(false)
 		}
 	case AggregationExplicitBucketHistogram:
 		var noSum bool
@@ -475,7 +551,7 @@ func (i *inserter[N]) aggregateFunc(b aggregate.Builder[N], agg Aggregation, kin
 			// https://github.com/open-telemetry/opentelemetry-specification/blob/v1.21.0/specification/metrics/sdk.md#histogram-aggregations
 			noSum = true
 		}
-		meas, comp = b.ExplicitBucketHistogram(a.Boundaries, a.NoMinMax, noSum)
+		meas, comp, bind = b.ExplicitBucketHistogram(a.Boundaries, a.NoMinMax, noSum)
 	case AggregationBase2ExponentialHistogram:
 		var noSum bool
 		switch kind {
@@ -485,13 +561,13 @@ func (i *inserter[N]) aggregateFunc(b aggregate.Builder[N], agg Aggregation, kin
 			// https://github.com/open-telemetry/opentelemetry-specification/blob/v1.21.0/specification/metrics/sdk.md#histogram-aggregations
 			noSum = true
 		}
-		meas, comp = b.ExponentialBucketHistogram(a.MaxSize, a.MaxScale, a.NoMinMax, noSum)
+		meas, comp, bind = b.ExponentialBucketHistogram(a.MaxSize, a.MaxScale, a.NoMinMax, noSum)
 
 	default:
 		err = errUnknownAggregation
 	}
 
-	return meas, comp, err
+	return meas, comp, bind, err
 }
 
 // isAggregatorCompatible checks if the aggregation can be used by the instrument.
@@ -598,26 +674,33 @@ func newResolver[N int64 | float64](p pipelines, vc *cache[string, instID]) reso
 }
 
 // Aggregators returns the Aggregators that must be updated by the instrument
-// defined by key.
-func (r resolver[N]) Aggregators(id Instrument) ([]aggregate.Measure[N], error) {
-	var measures []aggregate.Measure[N]
+// defined by key, and the binders of those that can be bound.
+func (r resolver[N]) Aggregators(id Instrument) ([]aggregate.Measure[N], []aggregate.Bind[N], error) {
+	var (
+		measures []aggregate.Measure[N]
+		binds    []aggregate.Bind[N]
+	)
 
 	errs := &multierror{}
 	for _, i := range r.inserters {
-		in, err := i.Instrument(id, i.readerDefaultAggregation(id.Kind))
+		in, b, err := i.Instrument(id, i.readerDefaultAggregation(id.Kind))
 		if err != nil {
 			errs.append(err)
 		}
 		measures = append(measures, in...)
+		binds = append(binds, b...)
 	}
-	return measures, errs.errorOrNil()
+	return measures, binds, errs.errorOrNil()
 }
 
 // HistogramAggregators returns the histogram Aggregators that must be updated by the instrument
 // defined by key. If boundaries were provided on instrument instantiation, those take precedence
 // over boundaries provided by the reader.
-func (r resolver[N]) HistogramAggregators(id Instrument, boundaries []float64) ([]aggregate.Measure[N], error) {
-	var measures []aggregate.Measure[N]
+func (r resolver[N]) HistogramAggregators(id Instrument, boundaries []float64) ([]aggregate.Measure[N], []aggregate.Bind[N], error) {
+	var (
+		measures []aggregate.Measure[N]
+		binds    []aggregate.Bind[N]
+	)
 
 	errs := &multierror{}
 	for _, i := range r.inserters {
@@ -626,13 +709,14 @@ func (r resolver[N]) HistogramAggregators(id Instrument, boundaries []float64) (
 			histAgg.Boundaries = boundaries
 			agg = histAgg
 		}
-		in, err := i.Instrument(id, agg)
+		in, b, err := i.Instrument(id, agg)
 		if err != nil {
 			errs.append(err)
 		}
 		measures = append(measures, in...)
+		binds = append(binds, b...)
 	}
-	return measures, errs.errorOrNil()
+	return measures, binds, errs.errorOrNil()
 }
 
 type multierror struct {
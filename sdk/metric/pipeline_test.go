@@ -47,7 +47,7 @@ func TestNewPipeline(t *testing.T) {
 	assert.Equal(t, resource.Empty(), output.Resource)
 	assert.Len(t, output.ScopeMetrics, 0)
 
-	iSync := instrumentSync{"name", "desc", "1", testSumAggregateOutput}
+	iSync := instrumentSync{"name", "desc", "1", 0, testSumAggregateOutput}
 	assert.NotPanics(t, func() {
 		pipe.addSync(instrumentation.Scope{}, iSync)
 	})
@@ -91,7 +91,7 @@ func TestPipelineConcurrentSafe(t *testing.T) {
 		go func(n int) {
 			defer wg.Done()
 			name := fmt.Sprintf("name %d", n)
-			sync := instrumentSync{name, "desc", "1", testSumAggregateOutput}
+			sync := instrumentSync{name, "desc", "1", 0, testSumAggregateOutput}
 			pipe.addSync(instrumentation.Scope{}, sync)
 		}(i)
 
@@ -139,7 +139,7 @@ func testDefaultViewImplicit[N int64 | float64]() func(t *testing.T) {
 				var c cache[string, instID]
 				i := newInserter[N](test.pipe, &c)
 				readerAggregation := i.readerDefaultAggregation(inst.Kind)
-				got, err := i.Instrument(inst, readerAggregation)
+				got, _, err := i.Instrument(inst, readerAggregation)
 				require.NoError(t, err)
 				assert.Len(t, got, 1, "default view not applied")
 				for _, in := range got {
@@ -366,7 +366,7 @@ func TestInserterCachedAggregatorNameConflict(t *testing.T) {
 	i := newInserter[int64](pipe, &vc)
 
 	readerAggregation := i.readerDefaultAggregation(kind)
-	_, origID, err := i.cachedAggregator(scope, kind, stream, readerAggregation)
+	_, _, origID, err := i.cachedAggregator(scope, kind, stream, readerAggregation)
 	require.NoError(t, err)
 
 	require.Len(t, pipe.aggregations, 1)
@@ -376,7 +376,7 @@ func TestInserterCachedAggregatorNameConflict(t *testing.T) {
 	require.Equal(t, name, iSync[0].name)
 
 	stream.Name = "RequestCount"
-	_, id, err := i.cachedAggregator(scope, kind, stream, readerAggregation)
+	_, _, id, err := i.cachedAggregator(scope, kind, stream, readerAggregation)
 	require.NoError(t, err)
 	assert.Equal(t, origID, id, "multiple aggregators for equivalent name")
 
@@ -504,3 +504,107 @@ func TestExemplars(t *testing.T) {
 		check(t, r, 0, 0, 0)
 	})
 }
+
+func TestCardinalityLimit(t *testing.T) {
+	const nAttrs = 10
+
+	setup := func(t *testing.T, opts []ManualReaderOption, views ...View) (*ManualReader, func() map[string]int) {
+		t.Helper()
+
+		r := NewManualReader(opts...)
+		m := NewMeterProvider(WithReader(r), WithView(views...)).Meter("scope")
+
+		route, err := m.Int64Counter("http.server.route")
+		require.NoError(t, err)
+		gauge, err := m.Int64UpDownCounter("queue.size")
+		require.NoError(t, err)
+
+		ctx := context.Background()
+		for i := 0; i < nAttrs; i++ {
+			opt := metric.WithAttributes(attribute.Int("id", i))
+			route.Add(ctx, 1, opt)
+			gauge.Add(ctx, 1, opt)
+		}
+
+		return r, func() map[string]int {
+			rm := new(metricdata.ResourceMetrics)
+			require.NoError(t, r.Collect(context.Background(), rm))
+			require.Len(t, rm.ScopeMetrics, 1, "ScopeMetrics")
+
+			got := make(map[string]int)
+			for _, m := range rm.ScopeMetrics[0].Metrics {
+				require.IsType(t, metricdata.Sum[int64]{}, m.Data, m.Name)
+				got[m.Name] = len(m.Data.(metricdata.Sum[int64]).DataPoints)
+			}
+			return got
+		}
+	}
+
+	routeView := func(limit int) View {
+		return NewView(Instrument{Name: "http.server.route"}, Stream{CardinalityLimit: limit})
+	}
+
+	t.Run("Unlimited", func(t *testing.T) {
+		_, collect := setup(t, nil)
+		assert.Equal(t, map[string]int{"http.server.route": nAttrs, "queue.size": nAttrs}, collect())
+	})
+
+	t.Run("Reader", func(t *testing.T) {
+		_, collect := setup(t, []ManualReaderOption{WithCardinalityLimit(3)})
+		assert.Equal(t, map[string]int{"http.server.route": 3, "queue.size": 3}, collect())
+	})
+
+	t.Run("View", func(t *testing.T) {
+		_, collect := setup(t, []ManualReaderOption{WithCardinalityLimit(3)}, routeView(5))
+		assert.Equal(t, map[string]int{"http.server.route": 5, "queue.size": 3}, collect())
+	})
+
+	t.Run("ViewUnlimited", func(t *testing.T) {
+		_, collect := setup(t, []ManualReaderOption{WithCardinalityLimit(3)}, routeView(-1))
+		assert.Equal(t, map[string]int{"http.server.route": nAttrs, "queue.size": 3}, collect())
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		r, _ := setup(t, nil, routeView(2))
+		rm := new(metricdata.ResourceMetrics)
+		require.NoError(t, r.Collect(context.Background(), rm))
+		var route metricdata.Metrics
+		for _, m := range rm.ScopeMetrics[0].Metrics {
+			if m.Name == "http.server.route" {
+				route = m
+			}
+		}
+		require.IsType(t, metricdata.Sum[int64]{}, route.Data)
+		dPts := route.Data.(metricdata.Sum[int64]).DataPoints
+		require.Len(t, dPts, 2)
+		overflow := attribute.NewSet(attribute.Bool("otel.metric.overflow", true))
+		var overflowed int64
+		for _, dPt := range dPts {
+			if dPt.Attributes.Equals(&overflow) {
+				overflowed = dPt.Value
+			}
+		}
+		assert.Equal(t, int64(nAttrs-1), overflowed, "overflow data-point value")
+	})
+
+	t.Run("OTEL_GO_X_CARDINALITY_LIMIT", func(t *testing.T) {
+		t.Setenv("OTEL_GO_X_CARDINALITY_LIMIT", "4")
+
+		_, collect := setup(t, nil, routeView(5))
+		assert.Equal(t, map[string]int{"http.server.route": 5, "queue.size": 4}, collect())
+
+		_, collect = setup(t, []ManualReaderOption{WithCardinalityLimit(2)})
+		assert.Equal(t, map[string]int{"http.server.route": 2, "queue.size": 2}, collect())
+
+		_, collect = setup(t, []ManualReaderOption{WithCardinalityLimit(-1)})
+		assert.Equal(t, map[string]int{"http.server.route": nAttrs, "queue.size": nAttrs}, collect())
+	})
+
+	t.Run("MarshalLog", func(t *testing.T) {
+		r, _ := setup(t, []ManualReaderOption{WithCardinalityLimit(3)}, routeView(5))
+		got := fmt.Sprintf("%+v", r.MarshalLog())
+		assert.Contains(t, got, "CardinalityLimit:3")
+		assert.Contains(t, got, "{Scope:scope Name:http.server.route Limit:5}")
+		assert.Contains(t, got, "{Scope:scope Name:queue.size Limit:3}")
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"sync/atomic"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
@@ -22,12 +23,23 @@ type MeterProvider struct {
 	embedded.MeterProvider
 
 	pipes  pipelines
-	meters cache[instrumentation.Scope, *meter]
+	meters cache[meterID, *meter]
+	// scopes holds the first Meter created for each instrumentation scope.
+	// The Meters created for the scope with other instrumentation
+	// attributes share its view cache and aggregate functions.
+	scopes cache[instrumentation.Scope, *meter]
 
 	forceFlush, shutdown func(context.Context) error
 	stopped              atomic.Bool
 }
 
+// meterID identifies a Meter: Meters are distinct for distinct
+// instrumentation scopes or instrumentation attributes.
+type meterID struct {
+	scope instrumentation.Scope
+	attrs attribute.Distinct
+}
+
 // Compile-time check MeterProvider implements metric.MeterProvider.
 var _ metric.MeterProvider = (*MeterProvider)(nil)
 
@@ -61,6 +73,13 @@ func NewMeterProvider(options ...Option) *MeterProvider {
 // telemetry. This name may be the same as the instrumented code only if that
 // code provides built-in instrumentation.
 //
+// Meters created with the same name, version, and schema URL but different
+// instrumentation attributes are distinct, and views select their
+// instruments using their own instrumentation attributes. Their instruments
+// are exported under the same instrumentation scope: instruments with the
+// same identity are aggregated into a single metric stream, and conflicting
+// instruments are logged as duplicate metric stream definitions.
+//
 // Calls to the Meter method after Shutdown has been called will return Meters
 // that perform no operations.
 //
@@ -87,8 +106,16 @@ func (mp *MeterProvider) Meter(name string, options ...metric.MeterOption) metri
 		"SchemaURL", s.SchemaURL,
 	)
 
-	return mp.meters.Lookup(s, func() *meter {
-		return newMeter(s, mp.pipes)
+	attrs := c.InstrumentationAttributes()
+	id := meterID{scope: s, attrs: attrs.Equivalent()}
+	return mp.meters.Lookup(id, func() *meter {
+		m := mp.scopes.Lookup(s, func() *meter {
+			return newMeter(s, attrs, mp.pipes)
+		})
+		if m.scopeAttrs.Equals(&attrs) {
+			return m
+		}
+		return m.withAttributes(attrs)
 	})
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	api "go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -95,6 +96,12 @@ func TestMeterProviderReturnsSameMeter(t *testing.T) {
 
 	assert.Same(t, mtr, mp.Meter(""))
 	assert.NotSame(t, mtr, mp.Meter("diff"))
+
+	// Instrumentation attributes are part of the Meter identity.
+	assert.Same(t, mtr, mp.Meter("", api.WithInstrumentationAttributes()))
+	attrMtr := mp.Meter("", api.WithInstrumentationAttributes(attribute.String("k", "v")))
+	assert.NotSame(t, mtr, attrMtr)
+	assert.Same(t, attrMtr, mp.Meter("", api.WithInstrumentationAttributes(attribute.String("k", "v"))))
 }
 
 func TestEmptyMeterName(t *testing.T) {
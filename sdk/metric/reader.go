@@ -6,6 +6,7 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
@@ -59,6 +60,21 @@ type Reader interface {
 	// Reader methods.
 	aggregation(InstrumentKind) Aggregation // nolint:revive  // import-shadow for method scoped by type.
 
+	// cardinalityLimit returns the cardinality limit of the streams that do
+	// not define their own. A value of zero means the Reader does not define
+	// a default, and a value less than zero means no limit.
+	//
+	// This method needs to be concurrent safe with itself and all the other
+	// Reader methods.
+	cardinalityLimit() int
+
+	// evictionPolicy returns the eviction policy of the streams that do not
+	// define their own.
+	//
+	// This method needs to be concurrent safe with itself and all the other
+	// Reader methods.
+	evictionPolicy() EvictionPolicy
+
 	// Collect gathers and returns all metric data related to the Reader from
 	// the SDK and stores it in out. An error is returned if this is called
 	// after Shutdown or if out is nil.
@@ -92,6 +108,21 @@ type sdkProducer interface {
 	//
 	// This method is safe to call concurrently.
 	produce(context.Context, *metricdata.ResourceMetrics) error
+
+	// cardinalityLimits returns the cardinality limit of each stream
+	// produced.
+	//
+	// This method is safe to call concurrently.
+	cardinalityLimits() []streamCardinalityLimit
+}
+
+// streamCardinalityLimit is the cardinality limit of a stream.
+type streamCardinalityLimit struct {
+	Scope string
+	Name  string
+	// Limit is the cardinality limit of the stream. A value less than or
+	// equal to zero means no limit.
+	Limit int
 }
 
 // Producer produces metrics for a Reader from an external source.
@@ -110,7 +141,18 @@ type Producer interface {
 // produceHolder is used as an atomic.Value to wrap the non-concrete producer
 // type.
 type produceHolder struct {
-	produce func(context.Context, *metricdata.ResourceMetrics) error
+	produce           func(context.Context, *metricdata.ResourceMetrics) error
+	cardinalityLimits func() []streamCardinalityLimit
+}
+
+// streamCardinalityLimits returns the cardinality limits of the streams of
+// the producer held in v, an atomic.Value holding a produceHolder.
+func streamCardinalityLimits(v *atomic.Value) []streamCardinalityLimit {
+	ph, ok := v.Load().(produceHolder)
+	if !ok || ph.cardinalityLimits == nil {
+		return nil
+	}
+	return ph.cardinalityLimits()
 }
 
 // shutdownProducer produces an ErrReaderShutdown error always.
@@ -187,3 +229,63 @@ func (o producerOption) applyPeriodic(c periodicReaderConfig) periodicReaderConf
 	c.producers = append(c.producers, o.p)
 	return c
 }
+
+// WithCardinalityLimit sets the default cardinality limit of the streams of
+// a Reader, the maximum number of distinct attribute sets aggregated for each
+// stream. Measurements made with new attribute sets once the limit is reached
+// are aggregated into a single data-point with the "otel.metric.overflow"
+// attribute.
+//
+// The CardinalityLimit of a Stream returned by a View takes precedence over
+// this default. If limit is less than zero, the streams without their own
+// limit have no limit.
+//
+// If this option is not used or limit is zero, the limit set with the
+// experimental OTEL_GO_X_CARDINALITY_LIMIT environment variable is used, if
+// any.
+func WithCardinalityLimit(limit int) ReaderOption {
+	return cardinalityLimitOption{limit: limit}
+}
+
+type cardinalityLimitOption struct {
+	limit int
+}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o cardinalityLimitOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.cardinalityLimit = o.limit
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (o cardinalityLimitOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	c.cardinalityLimit = o.limit
+	return c
+}
+
+// WithEvictionPolicy sets the default policy used to evict the stale
+// attribute sets of the streams of a Reader that have cumulative temporality.
+//
+// The EvictionPolicy of a Stream returned by a View takes precedence over
+// this default.
+//
+// If this option is not used, attribute sets are never evicted.
+func WithEvictionPolicy(policy EvictionPolicy) ReaderOption {
+	return evictionPolicyOption{policy: policy}
+}
+
+type evictionPolicyOption struct {
+	policy EvictionPolicy
+}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o evictionPolicyOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.evictionPolicy = o.policy
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (o evictionPolicyOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	c.evictionPolicy = o.policy
+	return c
+}
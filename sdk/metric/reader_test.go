@@ -259,6 +259,8 @@ func (p testSDKProducer) produce(ctx context.Context, rm *metricdata.ResourceMet
 	return nil
 }
 
+func (p testSDKProducer) cardinalityLimits() []streamCardinalityLimit { return nil }
+
 type testExternalProducer struct {
 	produceFunc func(context.Context) ([]metricdata.ScopeMetrics, error)
 }
@@ -8,12 +8,14 @@ import (
 	"regexp"
 	"strings"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 )
 
 var (
 	errMultiInst = errors.New("name replacement for multiple instruments")
 	errEmptyView = errors.New("no criteria provided for view")
+	errDupStream = errors.New("duplicate stream name for an instrument")
 
 	emptyView = func(Instrument) (Stream, bool) { return Stream{}, false }
 )
@@ -38,10 +40,13 @@ type View func(Instrument) (Stream, bool)
 //
 // The Stream mask only applies updates for non-zero-value fields. By default,
 // the Instrument the View matches against will be use for the Name,
-// Description, and Unit of the returned Stream and no Aggregation or
-// AttributeFilter are set. All non-zero-value fields of mask are used instead
-// of the default. If you need to zero out an Stream field returned from a
-// View, create a View directly.
+// Description, and Unit of the returned Stream and no Aggregation,
+// AttributeFilter, CardinalityLimit, or EvictionPolicy are set. All
+// non-zero-value fields of mask are used instead of the default. If you need
+// to zero out an Stream field returned from a View, create a View directly.
+//
+// Use NewViews for richer instrument selection or to produce multiple streams
+// from a single instrument.
 func NewView(criteria Instrument, mask Stream) View {
 	if criteria.IsEmpty() {
 		global.Error(
@@ -51,35 +56,45 @@ func NewView(criteria Instrument, mask Stream) View {
 		return emptyView
 	}
 
-	var matchFunc func(Instrument) bool
-	if strings.ContainsAny(criteria.Name, "*?") {
-		if mask.Name != "" {
-			global.Error(
-				errMultiInst, "dropping view",
-				"criteria", criteria,
-				"mask", mask,
-			)
-			return emptyView
-		}
+	if strings.ContainsAny(criteria.Name, "*?") && mask.Name != "" {
+		global.Error(
+			errMultiInst, "dropping view",
+			"criteria", criteria,
+			"mask", mask,
+		)
+		return emptyView
+	}
 
-		// Handle branching here in NewView instead of criteria.matches so
-		// criteria.matches remains inlinable for the simple case.
-		pattern := regexp.QuoteMeta(criteria.Name)
-		pattern = "^" + pattern + "$"
-		pattern = strings.ReplaceAll(pattern, `\?`, ".")
-		pattern = strings.ReplaceAll(pattern, `\*`, ".*")
-		re := regexp.MustCompile(pattern)
-		matchFunc = func(i Instrument) bool {
-			return re.MatchString(i.Name) &&
-				criteria.matchesDescription(i) &&
-				criteria.matchesKind(i) &&
-				criteria.matchesUnit(i) &&
-				criteria.matchesScope(i)
-		}
-	} else {
-		matchFunc = criteria.matches
+	return newMaskView(criteria.matcher(), mask, criteria)
+}
+
+// matcher returns a function that reports whether an Instrument matches all
+// the non-zero-value fields of i. The Name of i is matched as a wildcard
+// pattern if it contains any "*" or "?".
+func (i Instrument) matcher() func(Instrument) bool {
+	if !strings.ContainsAny(i.Name, "*?") {
+		return i.matches
 	}
 
+	// Handle branching here instead of i.matches so i.matches remains
+	// inlinable for the simple case.
+	pattern := regexp.QuoteMeta(i.Name)
+	pattern = "^" + pattern + "$"
+	pattern = strings.ReplaceAll(pattern, `\?`, ".")
+	pattern = strings.ReplaceAll(pattern, `\*`, ".*")
+	re := regexp.MustCompile(pattern)
+	return func(other Instrument) bool {
+		return re.MatchString(other.Name) &&
+			i.matchesDescription(other) &&
+			i.matchesKind(other) &&
+			i.matchesUnit(other) &&
+			i.matchesScope(other)
+	}
+}
+
+// newMaskView returns a View that applies mask to all instruments match
+// returns true for. The criteria is only used to log errors.
+func newMaskView(match func(Instrument) bool, mask Stream, criteria any) View {
 	var agg Aggregation
 	if mask.Aggregation != nil {
 		agg = mask.Aggregation.copy()
@@ -94,19 +109,157 @@ func NewView(criteria Instrument, mask Stream) View {
 	}
 
 	return func(i Instrument) (Stream, bool) {
-		if matchFunc(i) {
+		if match(i) {
 			return Stream{
-				Name:            nonZero(mask.Name, i.Name),
-				Description:     nonZero(mask.Description, i.Description),
-				Unit:            nonZero(mask.Unit, i.Unit),
-				Aggregation:     agg,
-				AttributeFilter: mask.AttributeFilter,
+				Name:             nonZero(mask.Name, i.Name),
+				Description:      nonZero(mask.Description, i.Description),
+				Unit:             nonZero(mask.Unit, i.Unit),
+				Aggregation:      agg,
+				AttributeFilter:  mask.AttributeFilter,
+				CardinalityLimit: mask.CardinalityLimit,
+				EvictionPolicy:   mask.EvictionPolicy,
 			}, true
 		}
 		return Stream{}, false
 	}
 }
 
+// Selector selects the instruments views returned from NewViews apply to. An
+// instrument is selected if it matches all the non-zero-value fields of the
+// Selector and none of its exclusions.
+type Selector struct {
+	// Instrument is matched the same way as the criteria of NewView,
+	// including the wildcard pattern matching of its Name.
+	Instrument Instrument
+	// NameRegexp, if not nil, needs to match the instrument name.
+	NameRegexp *regexp.Regexp
+	// ScopeNamePrefix, if not empty, needs to be a prefix of the name of the
+	// instrumentation scope that created the instrument.
+	ScopeNamePrefix string
+	// ScopeAttributes, if not empty, all need to be attributes of the
+	// instrumentation scope that created the instrument, the ones the Meter
+	// was created with. The scope can have additional attributes.
+	ScopeAttributes attribute.Set
+	// Exclude are the instruments not selected even if they match all other
+	// criteria. An instrument matching any of these is not selected. An empty
+	// Selector in Exclude excludes no instruments.
+	//
+	// If Exclude is the only non-zero-value field, all instruments except
+	// the ones matching Exclude are selected.
+	Exclude []Selector
+}
+
+// isEmpty returns if all fields of s are their zero-value.
+func (s Selector) isEmpty() bool {
+	return s.Instrument.IsEmpty() &&
+		s.NameRegexp == nil &&
+		s.ScopeNamePrefix == "" &&
+		s.ScopeAttributes.Len() == 0 &&
+		len(s.Exclude) == 0
+}
+
+// singleName returns if s selects instruments with at most one name.
+func (s Selector) singleName() bool {
+	return s.Instrument.Name != "" && !strings.ContainsAny(s.Instrument.Name, "*?")
+}
+
+// matcher returns a function that reports whether an Instrument is selected
+// by s.
+func (s Selector) matcher() func(Instrument) bool {
+	match := s.Instrument.matcher()
+	scopeAttrs := s.ScopeAttributes.ToSlice()
+	var exclude []func(Instrument) bool
+	for _, e := range s.Exclude {
+		if !e.isEmpty() {
+			exclude = append(exclude, e.matcher())
+		}
+	}
+
+	return func(i Instrument) bool {
+		if !match(i) {
+			return false
+		}
+		if s.NameRegexp != nil && !s.NameRegexp.MatchString(i.Name) {
+			return false
+		}
+		if !strings.HasPrefix(i.Scope.Name, s.ScopeNamePrefix) {
+			return false
+		}
+		for _, kv := range scopeAttrs {
+			if v, ok := i.scopeAttributes.Value(kv.Key); !ok || v != kv.Value {
+				return false
+			}
+		}
+		for _, e := range exclude {
+			if e(i) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// NewViews returns the Views that apply each of the Stream masks to all
+// instruments selected by selector, one View per mask. Every selected
+// instrument produces a stream for each mask. For example, both a histogram
+// and a sum of the same instrument can be produced. Pass all of the returned
+// Views to WithView. If selector is empty, all fields are their zero-values,
+// no Views are returned. If no masks are provided, a single View producing the
+// default stream of the selected instruments is returned.
+//
+// Each mask is applied the same way NewView applies its mask. The streams
+// produced for an instrument need distinct names, otherwise they are
+// duplicates of one another. A mask is dropped if it results in a name used by
+// a previous mask. Setting the Name of a mask is only supported if the Name
+// of the selector Instrument matches a single instrument name, e.g. it has no
+// wildcards.
+func NewViews(selector Selector, masks ...Stream) []View {
+	if selector.isEmpty() {
+		global.Error(
+			errEmptyView, "dropping view",
+			"masks", masks,
+		)
+		return nil
+	}
+
+	if len(masks) == 0 {
+		masks = []Stream{{}}
+	}
+
+	match := selector.matcher()
+	single := selector.singleName()
+	names := make(map[string]struct{}, len(masks))
+	views := make([]View, 0, len(masks))
+	for _, mask := range masks {
+		if mask.Name != "" && !single {
+			global.Error(
+				errMultiInst, "dropping view mask",
+				"selector", selector,
+				"mask", mask,
+			)
+			continue
+		}
+
+		name := mask.Name
+		if name == "" && single {
+			name = selector.Instrument.Name
+		}
+		name = strings.ToLower(name)
+		if _, ok := names[name]; ok {
+			global.Error(
+				errDupStream, "dropping view mask",
+				"selector", selector,
+				"mask", mask,
+			)
+			continue
+		}
+		names[name] = struct{}{}
+
+		views = append(views, newMaskView(match, mask, selector))
+	}
+	return views
+}
+
 // nonZero returns v if it is non-zero-valued, otherwise alt.
 func nonZero[T comparable](v, alt T) T {
 	var zero T
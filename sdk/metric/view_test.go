@@ -4,6 +4,7 @@
 package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -481,3 +482,203 @@ func TestNewViewMultiInstMatchErrorLogged(t *testing.T) {
 	})
 	assert.Contains(t, got, errMultiInst.Error())
 }
+
+func TestNewViewsSelect(t *testing.T) {
+	scopeAttrs := attribute.NewSet(
+		attribute.String("team", "storage"),
+		attribute.Int("shard", 1),
+	)
+	inst := completeIP
+	inst.scopeAttributes = scopeAttrs
+
+	tests := []struct {
+		name     string
+		selector Selector
+		want     bool
+	}{
+		{
+			name:     "Instrument",
+			selector: Selector{Instrument: Instrument{Name: "f?o"}},
+			want:     true,
+		},
+		{
+			name:     "InstrumentMismatch",
+			selector: Selector{Instrument: Instrument{Name: "bar"}},
+		},
+		{
+			name:     "NameRegexp",
+			selector: Selector{NameRegexp: regexp.MustCompile(`^fo+$`)},
+			want:     true,
+		},
+		{
+			name:     "NameRegexpMismatch",
+			selector: Selector{NameRegexp: regexp.MustCompile(`^bar`)},
+		},
+		{
+			name:     "ScopeNamePrefix",
+			selector: Selector{ScopeNamePrefix: "TestNewView"},
+			want:     true,
+		},
+		{
+			name:     "ScopeNamePrefixMismatch",
+			selector: Selector{ScopeNamePrefix: "Match"},
+		},
+		{
+			name: "ScopeAttributes",
+			selector: Selector{
+				ScopeAttributes: attribute.NewSet(attribute.String("team", "storage")),
+			},
+			want: true,
+		},
+		{
+			name: "ScopeAttributesValueMismatch",
+			selector: Selector{
+				ScopeAttributes: attribute.NewSet(attribute.String("team", "network")),
+			},
+		},
+		{
+			name: "ScopeAttributesKeyMismatch",
+			selector: Selector{
+				ScopeAttributes: attribute.NewSet(attribute.String("region", "eu")),
+			},
+		},
+		{
+			name: "Excluded",
+			selector: Selector{
+				Instrument: Instrument{Name: "*"},
+				Exclude:    []Selector{{Instrument: Instrument{Name: "foo"}}},
+			},
+		},
+		{
+			name: "NotExcluded",
+			selector: Selector{
+				Instrument: Instrument{Name: "*"},
+				Exclude:    []Selector{{Instrument: Instrument{Name: "bar"}}},
+			},
+			want: true,
+		},
+		{
+			name: "ExcludeOnly",
+			selector: Selector{
+				Exclude: []Selector{{NameRegexp: regexp.MustCompile(`^bar`)}},
+			},
+			want: true,
+		},
+		{
+			name: "ExcludeOnlyExcluded",
+			selector: Selector{
+				Exclude: []Selector{{ScopeNamePrefix: "Test"}},
+			},
+		},
+		{
+			name: "EmptyExclude",
+			selector: Selector{
+				Instrument: Instrument{Name: "foo"},
+				Exclude:    []Selector{{}},
+			},
+			want: true,
+		},
+		{
+			name: "All",
+			selector: Selector{
+				Instrument:      Instrument{Name: "foo", Kind: InstrumentKindCounter},
+				NameRegexp:      regexp.MustCompile(`o$`),
+				ScopeNamePrefix: "Test",
+				ScopeAttributes: scopeAttrs,
+			},
+			want: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			views := NewViews(test.selector)
+			require.Len(t, views, 1)
+			got, match := views[0](inst)
+			assert.Equal(t, test.want, match)
+			if test.want {
+				assert.Equal(t, Stream{
+					Name:        inst.Name,
+					Description: inst.Description,
+					Unit:        inst.Unit,
+				}, got)
+			}
+		})
+	}
+}
+
+func TestNewViewsMasks(t *testing.T) {
+	selector := Selector{Instrument: Instrument{Name: "foo"}}
+	views := NewViews(
+		selector,
+		Stream{Description: "histogram"},
+		Stream{Name: "foo.sum", Aggregation: AggregationSum{}},
+	)
+	require.Len(t, views, 2)
+
+	got, match := views[0](completeIP)
+	require.True(t, match)
+	assert.Equal(t, Stream{
+		Name:        "foo",
+		Description: "histogram",
+		Unit:        completeIP.Unit,
+	}, got)
+
+	got, match = views[1](completeIP)
+	require.True(t, match)
+	assert.Equal(t, Stream{
+		Name:        "foo.sum",
+		Description: completeIP.Description,
+		Unit:        completeIP.Unit,
+		Aggregation: AggregationSum{},
+	}, got)
+}
+
+func TestNewViewsErrorLogged(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector Selector
+		masks    []Stream
+		want     error
+		wantN    int
+	}{
+		{
+			name: "Empty",
+			want: errEmptyView,
+		},
+		{
+			name:     "MultiInst",
+			selector: Selector{NameRegexp: regexp.MustCompile(`^foo`)},
+			masks:    []Stream{{}, {Name: "bar"}},
+			want:     errMultiInst,
+			wantN:    1,
+		},
+		{
+			name:     "DuplicateName",
+			selector: Selector{Instrument: Instrument{Name: "foo"}},
+			masks:    []Stream{{}, {Name: "FOO"}, {Name: "bar"}},
+			want:     errDupStream,
+			wantN:    2,
+		},
+		{
+			name:     "DuplicateDefault",
+			selector: Selector{ScopeNamePrefix: "Test"},
+			masks:    []Stream{{}, {Description: "alt"}},
+			want:     errDupStream,
+			wantN:    1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got string
+			otel.SetLogger(funcr.New(func(_, args string) {
+				got = args
+			}, funcr.Options{Verbosity: 6}))
+
+			views := NewViews(test.selector, test.masks...)
+			assert.Len(t, views, test.wantN)
+			assert.Contains(t, got, test.want.Error())
+		})
+	}
+}
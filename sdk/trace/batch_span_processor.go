@@ -11,6 +11,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/internal/env"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -55,6 +56,36 @@ type BatchSpanProcessorOptions struct {
 	// Blocking option should be used carefully as it can severely affect the performance of an
 	// application.
 	BlockOnQueueFull bool
+
+	// MaxExportBatchBytes is the maximum estimated size, in bytes, of the
+	// spans of a single batch. A batch is exported before a span is added
+	// to it if the span would make it exceed this size. A span larger than
+	// this size is exported alone. The size of a span is estimated from the
+	// size of its protobuf encoding in OTLP. If zero or negative, the batches
+	// are only bounded by MaxExportBatchSize.
+	// The default value of MaxExportBatchBytes is 0.
+	MaxExportBatchBytes int
+
+	// MaxConcurrentExports is the maximum number of batches exported at the
+	// same time. If MaxConcurrentExports is greater than 1, the order of the
+	// spans is not kept: each batch is exported from its own goroutine, so
+	// the SpanExporter can be called with the batches in any order, and must
+	// be safe to be called concurrently. ForceFlush and Shutdown wait for all
+	// exports in progress to complete.
+	// The default value of MaxConcurrentExports is 1.
+	MaxConcurrentExports int
+
+	// SpanPriority, if not nil, makes the queue priority-aware: when the
+	// queue is full, the oldest queued span of the lowest priority class is
+	// dropped instead of the ended span. The ended span is only dropped if
+	// all queued spans have a higher priority. It has no effect if
+	// BlockOnQueueFull is true.
+	SpanPriority func(ReadOnlySpan) SpanPriority
+
+	// meterProvider is used to report the self-observability metrics of the
+	// BatchSpanProcessor. If nil, no metrics are reported. It is unexported
+	// so it is not part of the logged configuration.
+	meterProvider metric.MeterProvider
 }
 
 // batchSpanProcessor is a SpanProcessor that batches asynchronously-received
@@ -65,14 +96,25 @@ type batchSpanProcessor struct {
 
 	queue   chan ReadOnlySpan
 	dropped uint32
+	metrics *spanProcessorMetrics
+
+	// priorityQueue, if not nil, replaces queue for the ended spans.
+	priorityQueue *priorityQueue
 
 	batch      []ReadOnlySpan
+	batchBytes int
 	batchMutex sync.Mutex
 	timer      *time.Timer
 	stopWait   sync.WaitGroup
 	stopOnce   sync.Once
 	stopCh     chan struct{}
 	stopped    atomic.Bool
+
+	// exportSlots, if not nil, holds a value for each export in progress
+	// when exports are concurrent.
+	exportSlots chan struct{}
+	// exportWaitMu serializes the waits for all exports in progress.
+	exportWaitMu sync.Mutex
 }
 
 var _ SpanProcessor = (*batchSpanProcessor)(nil)
@@ -110,6 +152,15 @@ func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorO
 		queue:  make(chan ReadOnlySpan, o.MaxQueueSize),
 		stopCh: make(chan struct{}),
 	}
+	if o.MaxConcurrentExports > 1 {
+		bsp.exportSlots = make(chan struct{}, o.MaxConcurrentExports)
+	}
+	if o.SpanPriority != nil && !o.BlockOnQueueFull {
+		bsp.priorityQueue = newPriorityQueue(o.MaxQueueSize, o.SpanPriority)
+	}
+	bsp.metrics = newSpanProcessorMetrics(o.meterProvider, batchSpanProcessorComponentType, func() (int, int) {
+		return bsp.queueLen(), cap(bsp.queue)
+	})
 
 	bsp.stopWait.Add(1)
 	go func() {
@@ -148,6 +199,7 @@ func (bsp *batchSpanProcessor) Shutdown(ctx context.Context) error {
 		go func() {
 			close(bsp.stopCh)
 			bsp.stopWait.Wait()
+			bsp.metrics.shutdown()
 			if bsp.e != nil {
 				if err := bsp.e.Shutdown(ctx); err != nil {
 					otel.Handle(err)
@@ -189,7 +241,7 @@ func (bsp *batchSpanProcessor) ForceFlush(ctx context.Context) error {
 	var err error
 	if bsp.e != nil {
 		flushCh := make(chan struct{})
-		if bsp.enqueueBlockOnQueueFull(ctx, forceFlushSpan{flushed: flushCh}) {
+		if bsp.enqueueFlush(ctx, forceFlushSpan{flushed: flushCh}) {
 			select {
 			case <-bsp.stopCh:
 				// The batchSpanProcessor is Shutdown.
@@ -259,34 +311,153 @@ func WithBlocking() BatchSpanProcessorOption {
 	}
 }
 
-// exportSpans is a subroutine of processing and draining the queue.
+// WithMaxExportBatchBytes returns a BatchSpanProcessorOption that configures
+// the maximum estimated size, in bytes, of the spans of a single batch
+// exported by a BatchSpanProcessor.
+func WithMaxExportBatchBytes(size int) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.MaxExportBatchBytes = size
+	}
+}
+
+// WithMaxConcurrentExports returns a BatchSpanProcessorOption that configures
+// the maximum number of batches a BatchSpanProcessor exports at the same
+// time. If n is greater than 1, the SpanExporter must be safe to be called
+// concurrently, and the batches can be passed to it in any order.
+func WithMaxConcurrentExports(n int) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.MaxConcurrentExports = n
+	}
+}
+
+// WithPriorityQueue returns a BatchSpanProcessorOption that configures a
+// BatchSpanProcessor to drop the oldest queued span of the lowest priority
+// class when its queue is full. An ended span with a lower priority than all
+// queued spans is dropped instead. The priority of each ended span is
+// returned by priority, or by DefaultSpanPriority if priority is nil.
+//
+// The number of spans dropped is reported per priority class with the
+// otel.sdk.span.priority attribute of the self-observability metrics.
+//
+// This option has no effect if WithBlocking is used.
+func WithPriorityQueue(priority func(ReadOnlySpan) SpanPriority) BatchSpanProcessorOption {
+	if priority == nil {
+		priority = DefaultSpanPriority
+	}
+	return func(o *BatchSpanProcessorOptions) {
+		o.SpanPriority = priority
+	}
+}
+
+// WithBatchSpanProcessorMeterProvider returns a BatchSpanProcessorOption
+// that configures the MeterProvider a BatchSpanProcessor uses to report its
+// self-observability metrics: the number of spans processed, dropped, and
+// that failed to be exported, the duration of exports, and the size and
+// capacity of its queue.
+func WithBatchSpanProcessorMeterProvider(mp metric.MeterProvider) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.meterProvider = mp
+	}
+}
+
+// exportSpans is a subroutine of processing and draining the queue. It
+// exports the current batch and, if exports are concurrent, waits for all
+// exports in progress to complete.
 func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	bsp.timer.Reset(bsp.o.BatchTimeout)
 
+	if bsp.exportSlots != nil {
+		batch := bsp.takeBatch()
+		bsp.exportSlots <- struct{}{}
+		err := bsp.export(ctx, batch)
+		<-bsp.exportSlots
+		bsp.waitExports()
+		return err
+	}
+
 	bsp.batchMutex.Lock()
 	defer bsp.batchMutex.Unlock()
 
+	err := bsp.export(ctx, bsp.batch)
+
+	// A new batch is always created after exporting, even if the batch failed to be exported.
+	//
+	// It is up to the exporter to implement any type of retry logic if a batch is failing
+	// to be exported, since it is specific to the protocol and backend being sent to.
+	bsp.batch = bsp.batch[:0]
+	bsp.batchBytes = 0
+	return err
+}
+
+// exportBatch exports the current batch. If exports are concurrent, it
+// returns once an export slot is available and the export is started.
+func (bsp *batchSpanProcessor) exportBatch(ctx context.Context) {
+	if bsp.exportSlots == nil {
+		if err := bsp.exportSpans(ctx); err != nil {
+			otel.Handle(err)
+		}
+		return
+	}
+
+	bsp.timer.Reset(bsp.o.BatchTimeout)
+	batch := bsp.takeBatch()
+	if len(batch) == 0 {
+		return
+	}
+	bsp.exportSlots <- struct{}{}
+	// The export outlives the processing of the queue during Shutdown.
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() { <-bsp.exportSlots }()
+		if err := bsp.export(ctx, batch); err != nil {
+			otel.Handle(err)
+		}
+	}()
+}
+
+// takeBatch returns the current batch and replaces it with a new one.
+func (bsp *batchSpanProcessor) takeBatch() []ReadOnlySpan {
+	bsp.batchMutex.Lock()
+	defer bsp.batchMutex.Unlock()
+	batch := bsp.batch
+	bsp.batch = make([]ReadOnlySpan, 0, cap(batch))
+	bsp.batchBytes = 0
+	return batch
+}
+
+// waitExports waits for all exports in progress to complete.
+func (bsp *batchSpanProcessor) waitExports() {
+	bsp.exportWaitMu.Lock()
+	defer bsp.exportWaitMu.Unlock()
+	for i := 0; i < cap(bsp.exportSlots); i++ {
+		bsp.exportSlots <- struct{}{}
+	}
+	for i := 0; i < cap(bsp.exportSlots); i++ {
+		<-bsp.exportSlots
+	}
+}
+
+// export exports batch, bounded by the export timeout.
+func (bsp *batchSpanProcessor) export(ctx context.Context, batch []ReadOnlySpan) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
 	if bsp.o.ExportTimeout > 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, bsp.o.ExportTimeout)
 		defer cancel()
 	}
 
-	if l := len(bsp.batch); l > 0 {
-		global.Debug("exporting spans", "count", len(bsp.batch), "total_dropped", atomic.LoadUint32(&bsp.dropped))
-		err := bsp.e.ExportSpans(ctx, bsp.batch)
-
-		// A new batch is always created after exporting, even if the batch failed to be exported.
-		//
-		// It is up to the exporter to implement any type of retry logic if a batch is failing
-		// to be exported, since it is specific to the protocol and backend being sent to.
-		bsp.batch = bsp.batch[:0]
-
-		if err != nil {
-			return err
-		}
+	if bsp.priorityQueue != nil {
+		global.Debug("exporting spans", "count", len(batch), "total_dropped", atomic.LoadUint32(&bsp.dropped), "dropped_by_priority", bsp.priorityQueue.droppedByPriority())
+	} else {
+		global.Debug("exporting spans", "count", len(batch), "total_dropped", atomic.LoadUint32(&bsp.dropped))
 	}
-	return nil
+	start := time.Now()
+	err := bsp.e.ExportSpans(ctx, batch)
+	bsp.metrics.exported(int64(len(batch)), start, err)
+	return err
 }
 
 // processQueue removes spans from the `queue` channel until processor
@@ -302,53 +473,86 @@ func (bsp *batchSpanProcessor) processQueue() {
 		case <-bsp.stopCh:
 			return
 		case <-bsp.timer.C:
-			if err := bsp.exportSpans(ctx); err != nil {
-				otel.Handle(err)
-			}
+			bsp.exportBatch(ctx)
 		case sd := <-bsp.queue:
-			if ffs, ok := sd.(forceFlushSpan); ok {
-				close(ffs.flushed)
-				continue
-			}
-			bsp.batchMutex.Lock()
-			bsp.batch = append(bsp.batch, sd)
-			shouldExport := len(bsp.batch) >= bsp.o.MaxExportBatchSize
-			bsp.batchMutex.Unlock()
-			if shouldExport {
-				if !bsp.timer.Stop() {
-					<-bsp.timer.C
-				}
-				if err := bsp.exportSpans(ctx); err != nil {
-					otel.Handle(err)
-				}
+			bsp.processSpan(ctx, sd)
+		case <-bsp.priorityQueueReady():
+			for sd, ok := bsp.priorityQueue.pop(); ok; sd, ok = bsp.priorityQueue.pop() {
+				bsp.processSpan(ctx, sd)
 			}
 		}
 	}
 }
 
+// priorityQueueReady returns the channel signaled when spans are pushed to
+// the priority queue, or nil if the priority queue is not used.
+func (bsp *batchSpanProcessor) priorityQueueReady() <-chan struct{} {
+	if bsp.priorityQueue == nil {
+		return nil
+	}
+	return bsp.priorityQueue.ready
+}
+
+// processSpan adds sd to the batch and exports the batch if it is full.
+func (bsp *batchSpanProcessor) processSpan(ctx context.Context, sd ReadOnlySpan) {
+	if ffs, ok := sd.(forceFlushSpan); ok {
+		close(ffs.flushed)
+		return
+	}
+	bsp.addSpan(ctx, sd, func() {
+		if !bsp.timer.Stop() {
+			<-bsp.timer.C
+		}
+	})
+}
+
+// addSpan adds sd to the batch. The batch is exported before sd is added if
+// sd would make it exceed MaxExportBatchBytes, and after sd is added if it is
+// full. beforeExport, if not nil, is called before each export.
+func (bsp *batchSpanProcessor) addSpan(ctx context.Context, sd ReadOnlySpan, beforeExport func()) {
+	exportBatch := func() {
+		if beforeExport != nil {
+			beforeExport()
+		}
+		bsp.exportBatch(ctx)
+	}
+
+	var size int
+	if bsp.o.MaxExportBatchBytes > 0 {
+		size = estimateSpanSize(sd)
+		bsp.batchMutex.Lock()
+		exceeds := len(bsp.batch) > 0 && bsp.batchBytes+size > bsp.o.MaxExportBatchBytes
+		bsp.batchMutex.Unlock()
+		if exceeds {
+			exportBatch()
+		}
+	}
+
+	bsp.batchMutex.Lock()
+	bsp.batch = append(bsp.batch, sd)
+	bsp.batchBytes += size
+	shouldExport := len(bsp.batch) >= bsp.o.MaxExportBatchSize ||
+		(bsp.o.MaxExportBatchBytes > 0 && bsp.batchBytes >= bsp.o.MaxExportBatchBytes)
+	bsp.batchMutex.Unlock()
+	if shouldExport {
+		exportBatch()
+	}
+}
+
 // drainQueue awaits the any caller that had added to bsp.stopWait
 // to finish the enqueue, then exports the final batch.
 func (bsp *batchSpanProcessor) drainQueue() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	if bsp.priorityQueue != nil {
+		for sd, ok := bsp.priorityQueue.pop(); ok; sd, ok = bsp.priorityQueue.pop() {
+			bsp.drainSpan(ctx, sd)
+		}
+	}
 	for {
 		select {
 		case sd := <-bsp.queue:
-			if _, ok := sd.(forceFlushSpan); ok {
-				// Ignore flush requests as they are not valid spans.
-				continue
-			}
-
-			bsp.batchMutex.Lock()
-			bsp.batch = append(bsp.batch, sd)
-			shouldExport := len(bsp.batch) == bsp.o.MaxExportBatchSize
-			bsp.batchMutex.Unlock()
-
-			if shouldExport {
-				if err := bsp.exportSpans(ctx); err != nil {
-					otel.Handle(err)
-				}
-			}
+			bsp.drainSpan(ctx, sd)
 		default:
 			// There are no more enqueued spans. Make final export.
 			if err := bsp.exportSpans(ctx); err != nil {
@@ -359,9 +563,29 @@ func (bsp *batchSpanProcessor) drainQueue() {
 	}
 }
 
+// drainSpan adds sd to the batch while draining the queue and exports the
+// batch if it is full.
+func (bsp *batchSpanProcessor) drainSpan(ctx context.Context, sd ReadOnlySpan) {
+	if _, ok := sd.(forceFlushSpan); ok {
+		// Ignore flush requests as they are not valid spans.
+		return
+	}
+	bsp.addSpan(ctx, sd, nil)
+}
+
+// queueLen returns the number of queued spans.
+func (bsp *batchSpanProcessor) queueLen() int {
+	if bsp.priorityQueue != nil {
+		return bsp.priorityQueue.len()
+	}
+	return len(bsp.queue)
+}
+
 func (bsp *batchSpanProcessor) enqueue(sd ReadOnlySpan) {
 	ctx := context.TODO()
-	if bsp.o.BlockOnQueueFull {
+	if bsp.priorityQueue != nil {
+		bsp.enqueuePriority(sd)
+	} else if bsp.o.BlockOnQueueFull {
 		bsp.enqueueBlockOnQueueFull(ctx, sd)
 	} else {
 		bsp.enqueueDrop(ctx, sd)
@@ -381,6 +605,26 @@ func (bsp *batchSpanProcessor) enqueueBlockOnQueueFull(ctx context.Context, sd R
 	}
 }
 
+// enqueueFlush queues a flush request after the queued spans.
+func (bsp *batchSpanProcessor) enqueueFlush(ctx context.Context, ffs forceFlushSpan) bool {
+	if bsp.priorityQueue != nil {
+		bsp.priorityQueue.pushFlush(ffs)
+		return true
+	}
+	return bsp.enqueueBlockOnQueueFull(ctx, ffs)
+}
+
+func (bsp *batchSpanProcessor) enqueuePriority(sd ReadOnlySpan) {
+	if !sd.SpanContext().IsSampled() {
+		return
+	}
+
+	if dropped, p := bsp.priorityQueue.push(sd); dropped {
+		atomic.AddUint32(&bsp.dropped, 1)
+		bsp.metrics.droppedPriority(1, p)
+	}
+}
+
 func (bsp *batchSpanProcessor) enqueueDrop(ctx context.Context, sd ReadOnlySpan) bool {
 	if !sd.SpanContext().IsSampled() {
 		return false
@@ -391,6 +635,7 @@ func (bsp *batchSpanProcessor) enqueueDrop(ctx context.Context, sd ReadOnlySpan)
 		return true
 	default:
 		atomic.AddUint32(&bsp.dropped, 1)
+		bsp.metrics.dropped(1)
 	}
 	return false
 }
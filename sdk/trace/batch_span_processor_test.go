@@ -9,7 +9,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/internal/env"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -627,6 +630,159 @@ func TestBatchSpanProcessorConcurrentSafe(t *testing.T) {
 	wg.Wait()
 }
 
+func TestBatchSpanProcessorMaxExportBatchBytes(t *testing.T) {
+	ctx := context.Background()
+	var exp testBatchExporter
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(&exp,
+		sdktrace.WithMaxExportBatchBytes(2500),
+		sdktrace.WithBlocking(),
+	))
+	tr := tp.Tracer(t.Name())
+
+	large := attribute.String("large", strings.Repeat("x", 1000))
+	for i := 0; i < 5; i++ {
+		_, span := tr.Start(ctx, "large", trace.WithAttributes(large))
+		span.End()
+	}
+	_, span := tr.Start(ctx, "oversized", trace.WithAttributes(attribute.String("oversized", strings.Repeat("x", 5000))))
+	span.End()
+	_, span = tr.Start(ctx, "small")
+	span.End()
+	require.NoError(t, tp.Shutdown(ctx))
+
+	assert.Equal(t, 7, exp.len())
+	assert.Equal(t, []int{2, 2, 1, 1, 1}, exp.sizes, "batches should be bounded by their estimated size")
+}
+
+// concurrentExporter blocks all exports until released and records the
+// maximum number of concurrent exports.
+type concurrentExporter struct {
+	mu        sync.Mutex
+	active    int
+	maxActive int
+	spans     int
+	released  chan struct{}
+}
+
+func (e *concurrentExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	e.active++
+	if e.active > e.maxActive {
+		e.maxActive = e.active
+	}
+	e.mu.Unlock()
+
+	<-e.released
+
+	e.mu.Lock()
+	e.active--
+	e.spans += len(spans)
+	e.mu.Unlock()
+	return nil
+}
+
+func (e *concurrentExporter) Shutdown(context.Context) error { return nil }
+
+func (e *concurrentExporter) stats() (active, maxActive, spans int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.active, e.maxActive, e.spans
+}
+
+func TestBatchSpanProcessorMaxConcurrentExports(t *testing.T) {
+	ctx := context.Background()
+	exp := &concurrentExporter{released: make(chan struct{})}
+	bsp := sdktrace.NewBatchSpanProcessor(exp,
+		sdktrace.WithMaxConcurrentExports(3),
+		sdktrace.WithMaxExportBatchSize(1),
+		sdktrace.WithBlocking(),
+	)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(bsp))
+	tr := tp.Tracer(t.Name())
+
+	for i := 0; i < 6; i++ {
+		_, span := tr.Start(ctx, "span")
+		span.End()
+	}
+	assert.Eventually(t, func() bool {
+		active, _, _ := exp.stats()
+		return active == 3
+	}, 5*time.Second, time.Millisecond, "exports should run concurrently")
+
+	close(exp.released)
+	require.NoError(t, bsp.ForceFlush(ctx))
+	active, maxActive, spans := exp.stats()
+	assert.Equal(t, 0, active, "ForceFlush should wait for all exports")
+	assert.Equal(t, 3, maxActive)
+	assert.Equal(t, 6, spans)
+
+	require.NoError(t, tp.Shutdown(ctx))
+}
+
+// firstBlockingExporter blocks the export of the first batch until
+// released and records the names of the exported spans.
+type firstBlockingExporter struct {
+	// blocked is set by the first export, the other ones do not block.
+	blocked  atomic.Bool
+	started  chan struct{}
+	released chan struct{}
+
+	mu    sync.Mutex
+	names []string
+}
+
+func (e *firstBlockingExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if e.blocked.CompareAndSwap(false, true) {
+		close(e.started)
+		<-e.released
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, s := range spans {
+		e.names = append(e.names, s.Name())
+	}
+	return nil
+}
+
+func (e *firstBlockingExporter) Shutdown(context.Context) error { return nil }
+
+func (e *firstBlockingExporter) exported() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.names...)
+}
+
+func TestBatchSpanProcessorMaxConcurrentExportsOrder(t *testing.T) {
+	ctx := context.Background()
+	exp := &firstBlockingExporter{
+		started:  make(chan struct{}),
+		released: make(chan struct{}),
+	}
+	bsp := sdktrace.NewBatchSpanProcessor(exp,
+		sdktrace.WithMaxConcurrentExports(2),
+		sdktrace.WithMaxExportBatchSize(1),
+		sdktrace.WithBlocking(),
+	)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(bsp))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(ctx)) })
+	tr := tp.Tracer(t.Name())
+
+	_, span := tr.Start(ctx, "first")
+	span.End()
+	<-exp.started
+	_, span = tr.Start(ctx, "second")
+	span.End()
+
+	// The blocked export of the first batch does not hold back the second.
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"second"}, exp.exported())
+	}, 5*time.Second, time.Millisecond, "batches should be exported out of order")
+
+	close(exp.released)
+	require.NoError(t, bsp.ForceFlush(ctx))
+	assert.Equal(t, []string{"second", "first"}, exp.exported())
+}
+
 func BenchmarkSpanProcessor(b *testing.B) {
 	tp := sdktrace.NewTracerProvider(
 		sdktrace.WithBatcher(
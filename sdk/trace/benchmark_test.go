@@ -6,6 +6,8 @@ package trace_test
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
@@ -143,6 +145,103 @@ func BenchmarkStartEndSpan(b *testing.B) {
 	})
 }
 
+// lockedIDGenerator is an IDGenerator serializing all calls behind a single
+// mutex. It is the baseline the default IDGenerator is compared to in the
+// parallel benchmarks.
+type lockedIDGenerator struct {
+	sync.Mutex
+	randSource *rand.Rand
+}
+
+func newLockedIDGenerator() *lockedIDGenerator {
+	return &lockedIDGenerator{randSource: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+func (gen *lockedIDGenerator) NewIDs(context.Context) (trace.TraceID, trace.SpanID) {
+	gen.Lock()
+	defer gen.Unlock()
+	tid, sid := trace.TraceID{}, trace.SpanID{}
+	_, _ = gen.randSource.Read(tid[:])
+	_, _ = gen.randSource.Read(sid[:])
+	return tid, sid
+}
+
+func (gen *lockedIDGenerator) NewSpanID(context.Context, trace.TraceID) trace.SpanID {
+	gen.Lock()
+	defer gen.Unlock()
+	sid := trace.SpanID{}
+	_, _ = gen.randSource.Read(sid[:])
+	return sid
+}
+
+func BenchmarkStartEndSpanParallel(b *testing.B) {
+	for _, bc := range []struct {
+		name string
+		opts []sdktrace.TracerProviderOption
+	}{
+		{name: "DefaultIDGenerator"},
+		{name: "LockedIDGenerator", opts: []sdktrace.TracerProviderOption{sdktrace.WithIDGenerator(newLockedIDGenerator())}},
+		{name: "XRayIDGenerator", opts: []sdktrace.TracerProviderOption{sdktrace.WithXRayIDGenerator()}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			tr := sdktrace.NewTracerProvider(bc.opts...).Tracer(b.Name())
+			ctx := context.Background()
+			b.ReportAllocs()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					_, span := tr.Start(ctx, "/foo")
+					span.End()
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkIDGeneratorParallel(b *testing.B) {
+	for _, bc := range []struct {
+		name string
+		gen  sdktrace.IDGenerator
+	}{
+		{name: "Locked", gen: newLockedIDGenerator()},
+		{name: "XRay", gen: sdktrace.NewXRayIDGenerator()},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			ctx := context.Background()
+			b.ReportAllocs()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					tid, _ := bc.gen.NewIDs(ctx)
+					_ = bc.gen.NewSpanID(ctx, tid)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkStartEndSpanProfilerLabels(b *testing.B) {
+	for _, bc := range []struct {
+		name string
+		opts []sdktrace.TracerProviderOption
+	}{
+		{name: "Disabled"},
+		{name: "Enabled", opts: []sdktrace.TracerProviderOption{sdktrace.WithProfilerLabels()}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			tr := sdktrace.NewTracerProvider(bc.opts...).Tracer(b.Name())
+			ctx, parent := tr.Start(context.Background(), "/parent")
+			defer parent.End()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, span := tr.Start(ctx, "/foo")
+				span.End()
+			}
+		})
+	}
+}
+
 func BenchmarkSpanWithAttributes_4(b *testing.B) {
 	traceBenchmark(b, "Benchmark Start With 4 Attributes", func(b *testing.B, t trace.Tracer) {
 		ctx := context.Background()
@@ -0,0 +1,257 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultFanOutQueueSize is the default value of FanOutOptions.QueueSize.
+const DefaultFanOutQueueSize = 2048
+
+// FanOutOption configures a FanOutProcessor.
+type FanOutOption func(o *FanOutOptions)
+
+// FanOutOptions is configuration settings for a FanOutProcessor.
+type FanOutOptions struct {
+	// QueueSize is the maximum number of ended spans queued for each
+	// SpanProcessor. Spans ended while the queue of a SpanProcessor is full
+	// are dropped for that SpanProcessor.
+	// The default value of QueueSize is 2048.
+	QueueSize int
+}
+
+// WithFanOutQueueSize returns a FanOutOption that configures the maximum
+// number of ended spans queued for each SpanProcessor.
+func WithFanOutQueueSize(size int) FanOutOption {
+	return func(o *FanOutOptions) {
+		o.QueueSize = size
+	}
+}
+
+// fanOutItem is an item queued for a SpanProcessor of a FanOutProcessor. It
+// is either an ended span or a request to flush.
+type fanOutItem struct {
+	span  ReadOnlySpan
+	flush context.Context
+	done  chan error
+}
+
+type fanOutWorker struct {
+	processor SpanProcessor
+	queue     chan fanOutItem
+	stopped   chan struct{}
+}
+
+// run passes the queued items to the SpanProcessor of w until stop is
+// closed and the items queued until then are passed.
+func (w *fanOutWorker) run(stop <-chan struct{}) {
+	defer close(w.stopped)
+	for {
+		select {
+		case item := <-w.queue:
+			w.handle(item)
+		case <-stop:
+			for {
+				select {
+				case item := <-w.queue:
+					w.handle(item)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (w *fanOutWorker) handle(item fanOutItem) {
+	if item.done != nil {
+		item.done <- w.processor.ForceFlush(item.flush)
+		return
+	}
+	w.processor.OnEnd(item.span)
+}
+
+// FanOutProcessor is a SpanProcessor that passes spans to multiple
+// SpanProcessors while isolating them from each other. Ended spans are
+// queued for each SpanProcessor and passed to it from a dedicated goroutine,
+// so a slow SpanProcessor does not delay the others nor the caller ending
+// the span.
+//
+// Started spans are passed synchronously to all SpanProcessors, in order, so
+// they can modify the span before it is used.
+type FanOutProcessor struct {
+	workers []*fanOutWorker
+	o       FanOutOptions
+
+	// stopCh is closed on shutdown. The queues are never closed so that
+	// items can be sent to them without holding a lock.
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	dropped  atomic.Uint64
+}
+
+var _ SpanProcessor = (*FanOutProcessor)(nil)
+
+// NewFanOutProcessor returns a FanOutProcessor passing spans to processors.
+func NewFanOutProcessor(processors []SpanProcessor, options ...FanOutOption) *FanOutProcessor {
+	o := FanOutOptions{QueueSize: DefaultFanOutQueueSize}
+	for _, opt := range options {
+		opt(&o)
+	}
+	if o.QueueSize <= 0 {
+		o.QueueSize = DefaultFanOutQueueSize
+	}
+
+	fp := &FanOutProcessor{o: o, stopCh: make(chan struct{})}
+	for _, p := range processors {
+		if p == nil {
+			continue
+		}
+		w := &fanOutWorker{
+			processor: p,
+			queue:     make(chan fanOutItem, o.QueueSize),
+			stopped:   make(chan struct{}),
+		}
+		go w.run(fp.stopCh)
+		fp.workers = append(fp.workers, w)
+	}
+	return fp
+}
+
+// OnStart passes s to all SpanProcessors.
+func (fp *FanOutProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	for _, w := range fp.workers {
+		w.processor.OnStart(parent, s)
+	}
+}
+
+// OnEnd queues s for all SpanProcessors. If the queue of a SpanProcessor is
+// full, s is dropped for that SpanProcessor.
+func (fp *FanOutProcessor) OnEnd(s ReadOnlySpan) {
+	select {
+	case <-fp.stopCh:
+		return
+	default:
+	}
+	for _, w := range fp.workers {
+		select {
+		case w.queue <- fanOutItem{span: s}:
+		default:
+			fp.dropped.Add(1)
+		}
+	}
+}
+
+// DroppedSpans returns the number of spans dropped because the queue of a
+// SpanProcessor was full. A span dropped for multiple SpanProcessors is
+// counted once for each of them.
+func (fp *FanOutProcessor) DroppedSpans() uint64 {
+	return fp.dropped.Load()
+}
+
+// ForceFlush passes all queued spans to the SpanProcessors and flushes them.
+// The SpanProcessors are flushed concurrently.
+func (fp *FanOutProcessor) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	select {
+	case <-fp.stopCh:
+		return nil
+	default:
+	}
+	pending := make([]chan error, len(fp.workers))
+	for i, w := range fp.workers {
+		done := make(chan error, 1)
+		select {
+		case w.queue <- fanOutItem{flush: ctx, done: done}:
+			pending[i] = done
+		case <-fp.stopCh:
+			// The queued spans are flushed by Shutdown.
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	var errs []error
+	for i, w := range fp.workers {
+		var err error
+		select {
+		case err = <-pending[i]:
+		case <-w.stopped:
+			// The worker stopped, the flush was answered if it was handled.
+			select {
+			case err = <-pending[i]:
+			default:
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Shutdown passes all queued spans to the SpanProcessors and shuts them
+// down. The SpanProcessors are shut down concurrently.
+func (fp *FanOutProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	fp.stopOnce.Do(func() {
+		close(fp.stopCh)
+
+		results := make(chan error, len(fp.workers))
+		for _, w := range fp.workers {
+			go func(w *fanOutWorker) {
+				select {
+				case <-w.stopped:
+					results <- w.processor.Shutdown(ctx)
+				case <-ctx.Done():
+					results <- ctx.Err()
+				}
+			}(w)
+		}
+
+		var errs []error
+		for range fp.workers {
+			select {
+			case e := <-results:
+				if e != nil {
+					errs = append(errs, e)
+				}
+			case <-ctx.Done():
+				errs = append(errs, ctx.Err())
+				err = errors.Join(errs...)
+				return
+			}
+		}
+		err = errors.Join(errs...)
+	})
+	return err
+}
+
+// MarshalLog is the marshaling function used by the logging system to
+// represent this Span Processor.
+func (fp *FanOutProcessor) MarshalLog() interface{} {
+	processors := make([]SpanProcessor, len(fp.workers))
+	for i, w := range fp.workers {
+		processors[i] = w.processor
+	}
+	return struct {
+		Type       string
+		Processors []SpanProcessor
+		Config     FanOutOptions
+	}{
+		Type:       "FanOutProcessor",
+		Processors: processors,
+		Config:     fp.o,
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// blockingSpanProcessor is a SpanProcessor whose OnEnd blocks until it is
+// released.
+type blockingSpanProcessor struct {
+	received chan struct{}
+	release  chan struct{}
+	exp      *tracetest.InMemoryExporter
+}
+
+func newBlockingSpanProcessor() *blockingSpanProcessor {
+	return &blockingSpanProcessor{
+		received: make(chan struct{}, 100),
+		release:  make(chan struct{}),
+		exp:      tracetest.NewInMemoryExporter(),
+	}
+}
+
+func (p *blockingSpanProcessor) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+func (p *blockingSpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	p.received <- struct{}{}
+	<-p.release
+	_ = p.exp.ExportSpans(context.Background(), []sdktrace.ReadOnlySpan{s})
+}
+
+func (p *blockingSpanProcessor) Shutdown(context.Context) error   { return nil }
+func (p *blockingSpanProcessor) ForceFlush(context.Context) error { return nil }
+
+type annotatingSpanProcessor struct{}
+
+func (annotatingSpanProcessor) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	s.SetAttributes(attribute.Bool("annotated", true))
+}
+func (annotatingSpanProcessor) OnEnd(sdktrace.ReadOnlySpan)      {}
+func (annotatingSpanProcessor) Shutdown(context.Context) error   { return nil }
+func (annotatingSpanProcessor) ForceFlush(context.Context) error { return nil }
+
+func TestFanOutProcessorIsolatesSlowProcessor(t *testing.T) {
+	slow := newBlockingSpanProcessor()
+	fast := tracetest.NewInMemoryExporter()
+	fp := sdktrace.NewFanOutProcessor(
+		[]sdktrace.SpanProcessor{slow, sdktrace.NewSimpleSpanProcessor(fast)},
+		sdktrace.WithFanOutQueueSize(2),
+	)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(fp))
+	tr := tp.Tracer("TestFanOutProcessorIsolatesSlowProcessor")
+
+	for i := 1; i <= 5; i++ {
+		_, span := tr.Start(context.Background(), "span")
+		span.End()
+		if i == 1 {
+			// The slow processor blocks on the first span.
+			<-slow.received
+		}
+		require.Eventually(t, func() bool {
+			return len(fast.GetSpans()) == i
+		}, time.Second, time.Millisecond, "fast processor delayed by slow processor")
+	}
+
+	// The slow processor holds one span and has two queued, the two others
+	// are dropped for it only.
+	assert.Equal(t, uint64(2), fp.DroppedSpans())
+
+	close(slow.release)
+	require.NoError(t, fp.ForceFlush(context.Background()))
+	assert.Len(t, slow.exp.GetSpans(), 3)
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
+
+func TestFanOutProcessorOnStart(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	fp := sdktrace.NewFanOutProcessor([]sdktrace.SpanProcessor{
+		annotatingSpanProcessor{},
+		sdktrace.NewSimpleSpanProcessor(exp),
+	})
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(fp))
+	_, span := tp.Tracer("TestFanOutProcessorOnStart").Start(context.Background(), "span")
+	span.End()
+
+	require.NoError(t, fp.ForceFlush(context.Background()))
+	spans := exp.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Contains(t, spans[0].Attributes, attribute.Bool("annotated", true))
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
+
+func TestFanOutProcessorShutdown(t *testing.T) {
+	a, b := &testBatchExporter{}, &testBatchExporter{}
+	fp := sdktrace.NewFanOutProcessor([]sdktrace.SpanProcessor{
+		sdktrace.NewSimpleSpanProcessor(a),
+		sdktrace.NewSimpleSpanProcessor(b),
+		nil,
+	})
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(fp))
+	_, span := tp.Tracer("TestFanOutProcessorShutdown").Start(context.Background(), "span")
+	span.End()
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+	for _, exp := range []*testBatchExporter{a, b} {
+		assert.Equal(t, 1, exp.len(), "queued spans should be processed on shutdown")
+		assert.Equal(t, 1, exp.shutdownCount)
+	}
+
+	// Calls after shutdown are ignored.
+	fp.OnEnd(span.(sdktrace.ReadOnlySpan))
+	assert.NoError(t, fp.ForceFlush(context.Background()))
+	assert.NoError(t, fp.Shutdown(context.Background()))
+}
+
+func TestFanOutProcessorShutdownTimeout(t *testing.T) {
+	slow := newBlockingSpanProcessor()
+	defer close(slow.release)
+	fp := sdktrace.NewFanOutProcessor([]sdktrace.SpanProcessor{slow})
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(fp))
+	_, span := tp.Tracer("TestFanOutProcessorShutdownTimeout").Start(context.Background(), "span")
+	span.End()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, fp.Shutdown(ctx), context.DeadlineExceeded)
+}
+
+func TestFanOutProcessorForceFlushFullQueue(t *testing.T) {
+	slow := newBlockingSpanProcessor()
+	fp := sdktrace.NewFanOutProcessor([]sdktrace.SpanProcessor{slow}, sdktrace.WithFanOutQueueSize(1))
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(fp))
+	tr := tp.Tracer("TestFanOutProcessorForceFlushFullQueue")
+
+	_, span := tr.Start(context.Background(), "a")
+	span.End()
+	<-slow.received
+	_, span = tr.Start(context.Background(), "b")
+	span.End() // Fills the queue.
+
+	flushed := make(chan error, 1)
+	go func() { flushed <- fp.ForceFlush(context.Background()) }()
+
+	// A flush blocked on the full queue must not block ending spans nor
+	// shutting down.
+	_, span = tr.Start(context.Background(), "c")
+	span.End()
+	shutdown := make(chan error, 1)
+	go func() { shutdown <- fp.Shutdown(context.Background()) }()
+
+	close(slow.release)
+	require.NoError(t, <-shutdown)
+	require.NoError(t, <-flushed)
+	assert.Equal(t, uint64(1), fp.DroppedSpans())
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanFilter reports whether an ended span is kept.
+type SpanFilter func(ReadOnlySpan) bool
+
+// SpanKindFilter returns a SpanFilter that keeps spans of any of kinds.
+func SpanKindFilter(kinds ...trace.SpanKind) SpanFilter {
+	return func(s ReadOnlySpan) bool {
+		for _, k := range kinds {
+			if s.SpanKind() == k {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ScopeFilter returns a SpanFilter that keeps spans created by Tracers with
+// any of the instrumentation scope names.
+func ScopeFilter(names ...string) SpanFilter {
+	return func(s ReadOnlySpan) bool {
+		scope := s.InstrumentationScope().Name
+		for _, n := range names {
+			if scope == n {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// FilteringProcessor is a SpanProcessor that passes only the ended spans
+// kept by a SpanFilter to the next SpanProcessor.
+type FilteringProcessor struct {
+	next   SpanProcessor
+	filter SpanFilter
+}
+
+var _ SpanProcessor = (*FilteringProcessor)(nil)
+
+// NewFilteringProcessor returns a FilteringProcessor that passes ended spans
+// to next only if filter keeps them. Started spans are always passed to
+// next, the filter is evaluated once the span has ended and all its data is
+// known.
+//
+// If filter is nil, all spans are passed to next. If next is nil, all spans
+// are dropped.
+func NewFilteringProcessor(next SpanProcessor, filter SpanFilter) *FilteringProcessor {
+	return &FilteringProcessor{next: next, filter: filter}
+}
+
+// OnStart passes s to the next SpanProcessor.
+func (p *FilteringProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	if p.next == nil {
+		return
+	}
+	p.next.OnStart(parent, s)
+}
+
+// OnEnd passes s to the next SpanProcessor if it is kept by the filter.
+func (p *FilteringProcessor) OnEnd(s ReadOnlySpan) {
+	if p.next == nil || (p.filter != nil && !p.filter(s)) {
+		return
+	}
+	p.next.OnEnd(s)
+}
+
+// Shutdown shuts down the next SpanProcessor.
+func (p *FilteringProcessor) Shutdown(ctx context.Context) error {
+	if p.next == nil {
+		return nil
+	}
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the next SpanProcessor.
+func (p *FilteringProcessor) ForceFlush(ctx context.Context) error {
+	if p.next == nil {
+		return nil
+	}
+	return p.next.ForceFlush(ctx)
+}
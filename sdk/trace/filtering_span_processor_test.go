@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestFilteringProcessor(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(
+		sdktrace.NewFilteringProcessor(
+			sdktrace.NewSimpleSpanProcessor(exp),
+			sdktrace.SpanKindFilter(trace.SpanKindServer),
+		),
+	))
+	tr := tp.Tracer("TestFilteringProcessor")
+
+	_, span := tr.Start(context.Background(), "server", trace.WithSpanKind(trace.SpanKindServer))
+	span.End()
+	_, span = tr.Start(context.Background(), "client", trace.WithSpanKind(trace.SpanKindClient))
+	span.End()
+
+	assert.Equal(t, []string{"server"}, spanNames(exp.GetSpans()))
+	require.NoError(t, tp.ForceFlush(context.Background()))
+	require.NoError(t, tp.Shutdown(context.Background()))
+	assert.Empty(t, exp.GetSpans(), "exporter should be shut down")
+}
+
+func TestFilteringProcessorOnStart(t *testing.T) {
+	sp := &testSpanProcessor{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(
+		sdktrace.NewFilteringProcessor(sp, sdktrace.ScopeFilter("kept")),
+	))
+
+	_, span := tp.Tracer("kept").Start(context.Background(), "kept")
+	span.End()
+	_, span = tp.Tracer("dropped").Start(context.Background(), "dropped")
+	span.End()
+
+	assert.Len(t, sp.spansStarted, 2, "started spans should not be filtered")
+	require.Len(t, sp.spansEnded, 1)
+	assert.Equal(t, "kept", sp.spansEnded[0].Name())
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+	assert.Equal(t, 1, sp.shutdownCount)
+}
+
+func TestFilteringProcessorNilFilter(t *testing.T) {
+	sp := &testSpanProcessor{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sdktrace.NewFilteringProcessor(sp, nil)))
+	_, span := tp.Tracer("TestFilteringProcessorNilFilter").Start(context.Background(), "span")
+	span.End()
+	assert.Len(t, sp.spansEnded, 1)
+}
+
+func TestFilteringProcessorNilNext(t *testing.T) {
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sdktrace.NewFilteringProcessor(nil, nil)))
+	_, span := tp.Tracer("TestFilteringProcessorNilNext").Start(context.Background(), "span")
+	span.End()
+	assert.NoError(t, tp.ForceFlush(context.Background()))
+	assert.NoError(t, tp.Shutdown(context.Background()))
+}
@@ -7,9 +7,14 @@ import (
 	"context"
 	crand "crypto/rand"
 	"encoding/binary"
+	"fmt"
+	"io"
 	"math/rand"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -29,38 +34,169 @@ type IDGenerator interface {
 	// must never be done outside of a new major release.
 }
 
+// randPool is a pool of pseudo-random number generators, each seeded from a
+// cryptographically secure source. sync.Pool caches its items per P, so
+// concurrent callers draw from distinct generators without contending on a
+// lock.
+type randPool struct {
+	pool sync.Pool
+}
+
+func newRandPool() *randPool {
+	p := &randPool{}
+	p.pool.New = func() any {
+		return rand.New(rand.NewSource(randSeed(crand.Reader)))
+	}
+	return p
+}
+
+// seedCounter distinguishes the fallback seeds of generators seeded at the
+// same time.
+var seedCounter atomic.Uint64
+
+// randSeed returns a seed read from r. If r fails, the failure is reported
+// and the seed is derived from the current time mixed with a process-wide
+// counter, so generators seeded at the same time still differ.
+func randSeed(r io.Reader) int64 {
+	var seed int64
+	err := binary.Read(r, binary.LittleEndian, &seed)
+	if err == nil {
+		return seed
+	}
+	otel.Handle(fmt.Errorf("failed to seed ID generator from random source, using a time-based seed: %w", err))
+	// Spread the counter over all bits with the 64-bit golden ratio.
+	n := seedCounter.Add(1) * 0x9e3779b97f4a7c15
+	return time.Now().UnixNano() ^ int64(n)
+}
+
+// read fills b with pseudo-random bytes.
+func (p *randPool) read(b []byte) {
+	r := p.pool.Get().(*rand.Rand)
+	_, _ = r.Read(b)
+	p.pool.Put(r)
+}
+
 type randomIDGenerator struct {
-	sync.Mutex
-	randSource *rand.Rand
+	rand *randPool
 }
 
 var _ IDGenerator = &randomIDGenerator{}
 
 // NewSpanID returns a non-zero span ID from a randomly-chosen sequence.
 func (gen *randomIDGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
-	gen.Lock()
-	defer gen.Unlock()
 	sid := trace.SpanID{}
-	_, _ = gen.randSource.Read(sid[:])
+	gen.rand.read(sid[:])
 	return sid
 }
 
 // NewIDs returns a non-zero trace ID and a non-zero span ID from a
 // randomly-chosen sequence.
 func (gen *randomIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
-	gen.Lock()
-	defer gen.Unlock()
+	var b [24]byte
+	gen.rand.read(b[:])
 	tid := trace.TraceID{}
-	_, _ = gen.randSource.Read(tid[:])
+	copy(tid[:], b[:16])
 	sid := trace.SpanID{}
-	_, _ = gen.randSource.Read(sid[:])
+	copy(sid[:], b[16:])
 	return tid, sid
 }
 
 func defaultIDGenerator() IDGenerator {
-	gen := &randomIDGenerator{}
-	var rngSeed int64
-	_ = binary.Read(crand.Reader, binary.LittleEndian, &rngSeed)
-	gen.randSource = rand.New(rand.NewSource(rngSeed))
+	return &randomIDGenerator{rand: newRandPool()}
+}
+
+// XRayIDGeneratorOption configures the IDGenerator returned by
+// NewXRayIDGenerator.
+type XRayIDGeneratorOption interface {
+	apply(xrayIDGeneratorConfig) xrayIDGeneratorConfig
+}
+
+type xrayIDGeneratorConfig struct {
+	w3cRandom bool
+}
+
+type xrayIDGeneratorOptionFunc func(xrayIDGeneratorConfig) xrayIDGeneratorConfig
+
+func (fn xrayIDGeneratorOptionFunc) apply(cfg xrayIDGeneratorConfig) xrayIDGeneratorConfig {
+	return fn(cfg)
+}
+
+// WithXRayW3CRandom returns an XRayIDGeneratorOption that draws the
+// rightmost 7 bytes of the trace IDs from a cryptographically secure random
+// source. This satisfies the randomness requirement of the W3C Trace
+// Context Level 2 random trace flag.
+func WithXRayW3CRandom() XRayIDGeneratorOption {
+	return xrayIDGeneratorOptionFunc(func(cfg xrayIDGeneratorConfig) xrayIDGeneratorConfig {
+		cfg.w3cRandom = true
+		return cfg
+	})
+}
+
+// xrayIDGenerator generates trace IDs compatible with AWS X-Ray: the first 4
+// bytes are the big-endian epoch seconds of the trace start, the remaining
+// 12 bytes are random.
+type xrayIDGenerator struct {
+	rand *randPool
+	// w3cRandom, if not nil, is the source of the rightmost 7 bytes of trace
+	// IDs.
+	w3cRandom io.Reader
+	// w3cFallback reports the first failure of w3cRandom.
+	w3cFallback sync.Once
+	now         func() time.Time
+}
+
+var _ IDGenerator = &xrayIDGenerator{}
+
+// NewXRayIDGenerator returns an IDGenerator that generates AWS X-Ray
+// compatible trace IDs. The first 4 bytes of the trace IDs are the epoch
+// seconds at which they are generated, the remaining 12 bytes are random.
+// Span IDs are random.
+func NewXRayIDGenerator(opts ...XRayIDGeneratorOption) IDGenerator {
+	var cfg xrayIDGeneratorConfig
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	gen := &xrayIDGenerator{rand: newRandPool(), now: time.Now}
+	if cfg.w3cRandom {
+		gen.w3cRandom = crand.Reader
+	}
 	return gen
 }
+
+// NewSpanID returns a non-zero span ID from a randomly-chosen sequence.
+func (gen *xrayIDGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	return gen.newSpanID()
+}
+
+// NewIDs returns an X-Ray compatible trace ID and a non-zero span ID.
+func (gen *xrayIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	tid := trace.TraceID{}
+	binary.BigEndian.PutUint32(tid[:4], uint32(gen.now().Unix()))
+	if gen.w3cRandom == nil {
+		gen.rand.read(tid[4:])
+	} else {
+		gen.rand.read(tid[4:9])
+		// Read into a separate buffer so tid does not escape to the heap
+		// when w3cRandom is not used.
+		var r [7]byte
+		if _, err := io.ReadFull(gen.w3cRandom, r[:]); err != nil {
+			// Reported once, the source is not expected to recover.
+			gen.w3cFallback.Do(func() {
+				otel.Handle(fmt.Errorf("failed to read W3C random trace ID bytes, using pseudo-random bytes: %w", err))
+			})
+			gen.rand.read(r[:])
+		}
+		copy(tid[9:], r[:])
+	}
+	return tid, gen.newSpanID()
+}
+
+// newSpanID returns a non-zero span ID.
+func (gen *xrayIDGenerator) newSpanID() trace.SpanID {
+	sid := trace.SpanID{}
+	for !sid.IsValid() {
+		gen.rand.read(sid[:])
+	}
+	return sid
+}
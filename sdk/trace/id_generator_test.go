@@ -4,10 +4,19 @@
 package trace
 
 import (
+	"bytes"
 	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"sync"
 	"testing"
+	"testing/iotest"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/trace"
 )
@@ -39,3 +48,146 @@ func TestNewSpanIDWithInvalidTraceID(t *testing.T) {
 	spanID := gen.NewSpanID(context.Background(), trace.TraceID{})
 	assert.Truef(t, spanID.IsValid(), "span id: %s", spanID.String())
 }
+
+func TestXRayIDGeneratorTimestamp(t *testing.T) {
+	gen := NewXRayIDGenerator().(*xrayIDGenerator)
+	now := time.Unix(1700000000, 999)
+	gen.now = func() time.Time { return now }
+
+	traceID, spanID := gen.NewIDs(context.Background())
+	assert.Equal(t, uint32(1700000000), binary.BigEndian.Uint32(traceID[:4]))
+	assert.True(t, traceID.IsValid())
+	assert.True(t, spanID.IsValid())
+	assert.True(t, gen.NewSpanID(context.Background(), traceID).IsValid())
+}
+
+func TestXRayIDGeneratorW3CRandom(t *testing.T) {
+	gen := NewXRayIDGenerator(WithXRayW3CRandom()).(*xrayIDGenerator)
+	require.NotNil(t, gen.w3cRandom)
+
+	random := []byte{1, 2, 3, 4, 5, 6, 7}
+	gen.w3cRandom = bytes.NewReader(random)
+	traceID, _ := gen.NewIDs(context.Background())
+	assert.Equal(t, random, traceID[9:], "rightmost 7 bytes")
+
+	// The random source is exhausted, the pseudo-random source is used and
+	// the fallback is reported once.
+	handler.Reset()
+	defer handler.Reset()
+	traceID, _ = gen.NewIDs(context.Background())
+	assert.True(t, traceID.IsValid())
+	traceID, _ = gen.NewIDs(context.Background())
+	assert.True(t, traceID.IsValid())
+	assert.Len(t, handler.errs, 1)
+}
+
+func TestRandSeedFallback(t *testing.T) {
+	handler.Reset()
+	defer handler.Reset()
+
+	failing := iotest.ErrReader(errors.New("no entropy"))
+	a, b := randSeed(failing), randSeed(failing)
+	assert.NotEqual(t, a, b)
+	assert.Len(t, handler.errs, 2)
+
+	assert.Equal(t, int64(1), randSeed(bytes.NewReader([]byte{1, 0, 0, 0, 0, 0, 0, 0})))
+}
+
+func TestXRayIDGeneratorConcurrentUniqueness(t *testing.T) {
+	gen := NewXRayIDGenerator(WithXRayW3CRandom())
+	const goroutines, n = 8, 1000
+
+	var (
+		mu       sync.Mutex
+		traceIDs = make(map[trace.TraceID]struct{}, goroutines*n)
+		spanIDs  = make(map[trace.SpanID]struct{}, goroutines*n)
+		wg       sync.WaitGroup
+	)
+	start := uint32(time.Now().Unix())
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				traceID, spanID := gen.NewIDs(context.Background())
+				mu.Lock()
+				traceIDs[traceID] = struct{}{}
+				spanIDs[spanID] = struct{}{}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	end := uint32(time.Now().Unix())
+
+	assert.Len(t, traceIDs, goroutines*n, "duplicate trace IDs")
+	assert.Len(t, spanIDs, goroutines*n, "duplicate span IDs")
+	for traceID := range traceIDs {
+		ts := binary.BigEndian.Uint32(traceID[:4])
+		require.True(t, ts >= start && ts <= end, "trace ID timestamp %d not in [%d, %d]", ts, start, end)
+	}
+}
+
+func TestWithXRayIDGenerator(t *testing.T) {
+	tp := NewTracerProvider(WithXRayIDGenerator())
+	start := uint32(time.Now().Unix())
+	_, span := tp.Tracer("TestWithXRayIDGenerator").Start(context.Background(), "span")
+	span.End()
+
+	traceID := span.SpanContext().TraceID()
+	ts := binary.BigEndian.Uint32(traceID[:4])
+	assert.GreaterOrEqual(t, ts, start)
+	assert.LessOrEqual(t, ts, uint32(time.Now().Unix()))
+}
+
+// mutexIDGenerator is the mutex guarded IDGenerator the pooled default
+// IDGenerator replaced. It is kept as a benchmark baseline.
+type mutexIDGenerator struct {
+	sync.Mutex
+	randSource *rand.Rand
+}
+
+func newMutexIDGenerator() *mutexIDGenerator {
+	var rngSeed int64
+	_ = binary.Read(crand.Reader, binary.LittleEndian, &rngSeed)
+	return &mutexIDGenerator{randSource: rand.New(rand.NewSource(rngSeed))}
+}
+
+func (gen *mutexIDGenerator) NewSpanID(context.Context, trace.TraceID) trace.SpanID {
+	gen.Lock()
+	defer gen.Unlock()
+	sid := trace.SpanID{}
+	_, _ = gen.randSource.Read(sid[:])
+	return sid
+}
+
+func (gen *mutexIDGenerator) NewIDs(context.Context) (trace.TraceID, trace.SpanID) {
+	gen.Lock()
+	defer gen.Unlock()
+	tid := trace.TraceID{}
+	_, _ = gen.randSource.Read(tid[:])
+	sid := trace.SpanID{}
+	_, _ = gen.randSource.Read(sid[:])
+	return tid, sid
+}
+
+func BenchmarkDefaultIDGeneratorParallel(b *testing.B) {
+	for _, bb := range []struct {
+		name string
+		gen  IDGenerator
+	}{
+		{"Pooled", defaultIDGenerator()},
+		{"Mutex", newMutexIDGenerator()},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			ctx := context.Background()
+			b.ReportAllocs()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					tid, _ := bb.gen.NewIDs(ctx)
+					_ = bb.gen.NewSpanID(ctx, tid)
+				}
+			})
+		})
+	}
+}
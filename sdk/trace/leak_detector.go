@@ -0,0 +1,371 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Defaults for LeakDetectorOptions.
+const (
+	DefaultLeakMaxAge          = time.Minute
+	DefaultLeakCheckInterval   = 10 * time.Second
+	DefaultLeakMaxTrackedSpans = 10000
+)
+
+// leakStackDepth is the maximum number of frames captured for the start
+// stack trace of a span.
+const leakStackDepth = 32
+
+// OpenSpan describes a span that is started and not yet ended.
+type OpenSpan struct {
+	// Name is the name of the span when it was reported or listed.
+	Name string
+	// InstrumentationScope is the instrumentation scope of the Tracer that
+	// started the span.
+	InstrumentationScope instrumentation.Scope
+	// SpanContext is the span context of the span.
+	SpanContext trace.SpanContext
+	// StartTime is the start time of the span.
+	StartTime time.Time
+	// Stack is the stack trace of the goroutine that started the span. It is
+	// empty unless the LeakDetector is configured with WithLeakStackTrace.
+	Stack string
+}
+
+// LeakedSpanError is the error passed to otel.Handle for a span that is not
+// ended after the maximum age of a LeakDetector.
+type LeakedSpanError struct {
+	Span OpenSpan
+	Age  time.Duration
+}
+
+// Error returns a description of the leaked span.
+func (e *LeakedSpanError) Error() string {
+	msg := fmt.Sprintf(
+		"span %q of scope %q (trace ID %s, span ID %s) not ended %s after it started",
+		e.Span.Name,
+		e.Span.InstrumentationScope.Name,
+		e.Span.SpanContext.TraceID(),
+		e.Span.SpanContext.SpanID(),
+		e.Age.Round(time.Millisecond),
+	)
+	if e.Span.Stack != "" {
+		msg += ", started at:\n" + e.Span.Stack
+	}
+	return msg
+}
+
+// LeakDetectorOption configures a LeakDetector.
+type LeakDetectorOption func(o *LeakDetectorOptions)
+
+// LeakDetectorOptions is configuration settings for a LeakDetector.
+type LeakDetectorOptions struct {
+	// MaxAge is the age after which a span that is not ended is reported
+	// as leaked.
+	// The default value of MaxAge is 1 minute.
+	MaxAge time.Duration
+
+	// CheckInterval is the interval between two checks for leaked spans.
+	// The default value of CheckInterval is 10 seconds.
+	CheckInterval time.Duration
+
+	// MaxTrackedSpans is the maximum number of spans tracked at any time.
+	// Spans started once the limit is reached are not tracked, and are
+	// counted by UntrackedSpans.
+	// The default value of MaxTrackedSpans is 10000.
+	MaxTrackedSpans int
+
+	// StackTrace, if true, captures the stack trace of the goroutine that
+	// starts each span. This is expensive and meant for tests and debugging.
+	StackTrace bool
+
+	// OnLeak, if not nil, is called with each leaked span instead of passing
+	// a LeakedSpanError to otel.Handle. It is called once per leaked span,
+	// from the goroutine of the LeakDetector.
+	OnLeak func(OpenSpan)
+}
+
+// WithLeakMaxAge returns a LeakDetectorOption that configures the age after
+// which a span that is not ended is reported as leaked.
+func WithLeakMaxAge(age time.Duration) LeakDetectorOption {
+	return func(o *LeakDetectorOptions) {
+		o.MaxAge = age
+	}
+}
+
+// WithLeakCheckInterval returns a LeakDetectorOption that configures the
+// interval between two checks for leaked spans.
+func WithLeakCheckInterval(interval time.Duration) LeakDetectorOption {
+	return func(o *LeakDetectorOptions) {
+		o.CheckInterval = interval
+	}
+}
+
+// WithLeakMaxTrackedSpans returns a LeakDetectorOption that configures the
+// maximum number of spans tracked at any time.
+func WithLeakMaxTrackedSpans(n int) LeakDetectorOption {
+	return func(o *LeakDetectorOptions) {
+		o.MaxTrackedSpans = n
+	}
+}
+
+// WithLeakStackTrace returns a LeakDetectorOption that captures the stack
+// trace of the goroutine that starts each span.
+func WithLeakStackTrace() LeakDetectorOption {
+	return func(o *LeakDetectorOptions) {
+		o.StackTrace = true
+	}
+}
+
+// WithLeakHandler returns a LeakDetectorOption that configures the function
+// called with each leaked span instead of otel.Handle.
+func WithLeakHandler(f func(OpenSpan)) LeakDetectorOption {
+	return func(o *LeakDetectorOptions) {
+		o.OnLeak = f
+	}
+}
+
+// leakKey identifies a span tracked by a LeakDetector.
+type leakKey struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+type trackedSpan struct {
+	span  ReadWriteSpan
+	stack []uintptr
+}
+
+// LeakDetector is a diagnostic SpanProcessor that tracks the started spans
+// until they end and reports the spans that are not ended after a maximum
+// age. Each leaked span is reported once, through otel.Handle or the
+// function configured with WithLeakHandler, and is no longer tracked
+// afterwards.
+//
+// Use OpenSpans to assert that all spans are ended, for example at the end
+// of an integration test.
+type LeakDetector struct {
+	o   LeakDetectorOptions
+	now func() time.Time
+
+	mu   sync.Mutex
+	open map[leakKey]*trackedSpan
+
+	untracked atomic.Uint64
+
+	stopCh   chan struct{}
+	stopWait sync.WaitGroup
+	stopOnce sync.Once
+}
+
+var _ SpanProcessor = (*LeakDetector)(nil)
+
+// NewLeakDetector returns a new LeakDetector configured with options.
+// Register it with TracerProvider.RegisterSpanProcessor or the
+// WithSpanProcessor option, and shut it down to stop its checks.
+func NewLeakDetector(options ...LeakDetectorOption) *LeakDetector {
+	o := LeakDetectorOptions{
+		MaxAge:          DefaultLeakMaxAge,
+		CheckInterval:   DefaultLeakCheckInterval,
+		MaxTrackedSpans: DefaultLeakMaxTrackedSpans,
+	}
+	for _, opt := range options {
+		opt(&o)
+	}
+	if o.MaxAge <= 0 {
+		o.MaxAge = DefaultLeakMaxAge
+	}
+	if o.CheckInterval <= 0 {
+		o.CheckInterval = DefaultLeakCheckInterval
+	}
+	if o.MaxTrackedSpans <= 0 {
+		o.MaxTrackedSpans = DefaultLeakMaxTrackedSpans
+	}
+
+	ld := &LeakDetector{
+		o:      o,
+		now:    time.Now,
+		open:   make(map[leakKey]*trackedSpan),
+		stopCh: make(chan struct{}),
+	}
+
+	ld.stopWait.Add(1)
+	go func() {
+		defer ld.stopWait.Done()
+		ticker := time.NewTicker(o.CheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				ld.check()
+			case <-ld.stopCh:
+				return
+			}
+		}
+	}()
+
+	return ld
+}
+
+// OnStart tracks s until it ends or is reported as leaked. If the maximum
+// number of tracked spans is reached, s is not tracked.
+func (ld *LeakDetector) OnStart(_ context.Context, s ReadWriteSpan) {
+	ts := &trackedSpan{span: s}
+	if ld.o.StackTrace {
+		pcs := make([]uintptr, leakStackDepth)
+		// Skip runtime.Callers, OnStart, and the span processor loop of the
+		// Tracer.
+		ts.stack = pcs[:runtime.Callers(3, pcs)]
+	}
+	sc := s.SpanContext()
+	ld.mu.Lock()
+	if len(ld.open) >= ld.o.MaxTrackedSpans {
+		ld.mu.Unlock()
+		if ld.untracked.Add(1) == 1 {
+			global.Warn("leak detector full: not tracking spans", "max_tracked_spans", ld.o.MaxTrackedSpans)
+		}
+		return
+	}
+	ld.open[leakKey{traceID: sc.TraceID(), spanID: sc.SpanID()}] = ts
+	ld.mu.Unlock()
+}
+
+// OnEnd stops tracking s.
+func (ld *LeakDetector) OnEnd(s ReadOnlySpan) {
+	sc := s.SpanContext()
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	delete(ld.open, leakKey{traceID: sc.TraceID(), spanID: sc.SpanID()})
+}
+
+// OpenSpans returns the tracked spans that are started and not yet ended,
+// oldest first. Spans already reported as leaked are not returned.
+func (ld *LeakDetector) OpenSpans() []OpenSpan {
+	ld.mu.Lock()
+	tracked := make([]*trackedSpan, 0, len(ld.open))
+	for _, ts := range ld.open {
+		tracked = append(tracked, ts)
+	}
+	ld.mu.Unlock()
+
+	spans := make([]OpenSpan, len(tracked))
+	for i, ts := range tracked {
+		spans[i] = ts.openSpan()
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].StartTime.Before(spans[j].StartTime) })
+	return spans
+}
+
+// check reports the spans older than the maximum age and stops tracking
+// them.
+func (ld *LeakDetector) check() {
+	now := ld.now()
+	var leaked []*trackedSpan
+	ld.mu.Lock()
+	for k, ts := range ld.open {
+		if now.Sub(ts.span.StartTime()) >= ld.o.MaxAge {
+			delete(ld.open, k)
+			leaked = append(leaked, ts)
+		}
+	}
+	ld.mu.Unlock()
+
+	sort.Slice(leaked, func(i, j int) bool { return leaked[i].span.StartTime().Before(leaked[j].span.StartTime()) })
+	for _, ts := range leaked {
+		s := ts.openSpan()
+		if ld.o.OnLeak != nil {
+			ld.o.OnLeak(s)
+			continue
+		}
+		otel.Handle(&LeakedSpanError{Span: s, Age: now.Sub(s.StartTime)})
+	}
+}
+
+func (ts *trackedSpan) openSpan() OpenSpan {
+	return OpenSpan{
+		Name:                 ts.span.Name(),
+		InstrumentationScope: ts.span.InstrumentationScope(),
+		SpanContext:          ts.span.SpanContext(),
+		StartTime:            ts.span.StartTime(),
+		Stack:                formatStack(ts.stack),
+	}
+}
+
+// formatStack returns the function, file, and line of each frame of pcs.
+func formatStack(pcs []uintptr) string {
+	if len(pcs) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		f, more := frames.Next()
+		fmt.Fprintf(&b, "%s\n\t%s:%d\n", f.Function, f.File, f.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
+
+// Shutdown stops the checks for leaked spans. The spans not ended are not
+// reported.
+func (ld *LeakDetector) Shutdown(ctx context.Context) error {
+	var err error
+	ld.stopOnce.Do(func() {
+		wait := make(chan struct{})
+		go func() {
+			close(ld.stopCh)
+			ld.stopWait.Wait()
+			close(wait)
+		}()
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	})
+	return err
+}
+
+// ForceFlush reports the spans older than the maximum age and stops tracking
+// them.
+func (ld *LeakDetector) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	ld.check()
+	return nil
+}
+
+// UntrackedSpans returns the number of spans that were not tracked because
+// the maximum number of tracked spans was reached.
+func (ld *LeakDetector) UntrackedSpans() uint64 {
+	return ld.untracked.Load()
+}
+
+// MarshalLog is the marshaling function used by the logging system to
+// represent this Span Processor.
+func (ld *LeakDetector) MarshalLog() interface{} {
+	return struct {
+		Type   string
+		MaxAge time.Duration
+	}{
+		Type:   "LeakDetector",
+		MaxAge: ld.o.MaxAge,
+	}
+}
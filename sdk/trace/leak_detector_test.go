@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestLeakDetectorOpenSpans(t *testing.T) {
+	ld := sdktrace.NewLeakDetector()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(ld))
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+	tr := tp.Tracer("leaky")
+
+	start := time.Now()
+	_, first := tr.Start(context.Background(), "first")
+	_, second := tr.Start(context.Background(), "second")
+	first.(interface{ SetName(string) }).SetName("renamed")
+
+	open := ld.OpenSpans()
+	require.Len(t, open, 2)
+	assert.Equal(t, "renamed", open[0].Name)
+	assert.Equal(t, "leaky", open[0].InstrumentationScope.Name)
+	assert.Equal(t, first.SpanContext(), open[0].SpanContext)
+	assert.False(t, open[0].StartTime.Before(start))
+	assert.Empty(t, open[0].Stack)
+	assert.Equal(t, "second", open[1].Name)
+
+	first.End()
+	second.End()
+	assert.Empty(t, ld.OpenSpans())
+}
+
+func TestLeakDetectorReportsOnce(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		leaked []sdktrace.OpenSpan
+	)
+	ld := sdktrace.NewLeakDetector(
+		sdktrace.WithLeakMaxAge(time.Nanosecond),
+		sdktrace.WithLeakCheckInterval(time.Hour),
+		sdktrace.WithLeakStackTrace(),
+		sdktrace.WithLeakHandler(func(s sdktrace.OpenSpan) {
+			mu.Lock()
+			defer mu.Unlock()
+			leaked = append(leaked, s)
+		}),
+	)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(ld))
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+	_, span := tp.Tracer("leaky").Start(context.Background(), "leaked")
+	time.Sleep(time.Millisecond)
+	require.NoError(t, ld.ForceFlush(context.Background()))
+	require.NoError(t, ld.ForceFlush(context.Background()))
+
+	mu.Lock()
+	require.Len(t, leaked, 1, "leaked span should be reported once")
+	assert.Equal(t, "leaked", leaked[0].Name)
+	assert.Contains(t, leaked[0].Stack, "TestLeakDetectorReportsOnce", "stack should start at the caller")
+	mu.Unlock()
+
+	assert.Empty(t, ld.OpenSpans(), "reported span should no longer be tracked")
+	span.End()
+}
+
+func TestLeakDetectorMaxTrackedSpans(t *testing.T) {
+	ld := sdktrace.NewLeakDetector(sdktrace.WithLeakMaxTrackedSpans(2))
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(ld))
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+	tr := tp.Tracer("leaky")
+
+	_, first := tr.Start(context.Background(), "first")
+	_, second := tr.Start(context.Background(), "second")
+	_, third := tr.Start(context.Background(), "third")
+	assert.Len(t, ld.OpenSpans(), 2)
+	assert.Equal(t, uint64(1), ld.UntrackedSpans())
+
+	first.End()
+	third.End()
+	_, fourth := tr.Start(context.Background(), "fourth")
+	open := ld.OpenSpans()
+	require.Len(t, open, 2)
+	assert.Equal(t, "second", open[0].Name)
+	assert.Equal(t, "fourth", open[1].Name)
+	assert.Equal(t, uint64(1), ld.UntrackedSpans())
+
+	second.End()
+	fourth.End()
+	assert.Empty(t, ld.OpenSpans())
+}
+
+type leakErrorHandler struct {
+	errs chan error
+}
+
+func (h leakErrorHandler) Handle(err error) {
+	select {
+	case h.errs <- err:
+	default:
+	}
+}
+
+func TestLeakDetectorHandlesError(t *testing.T) {
+	h := leakErrorHandler{errs: make(chan error, 1)}
+	prev := otel.GetErrorHandler()
+	otel.SetErrorHandler(h)
+	t.Cleanup(func() { otel.SetErrorHandler(prev) })
+
+	ld := sdktrace.NewLeakDetector(
+		sdktrace.WithLeakMaxAge(time.Nanosecond),
+		sdktrace.WithLeakCheckInterval(time.Millisecond),
+	)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(ld))
+	_, span := tp.Tracer("leaky").Start(context.Background(), "leaked")
+	defer span.End()
+
+	select {
+	case err := <-h.errs:
+		var leakErr *sdktrace.LeakedSpanError
+		require.True(t, errors.As(err, &leakErr))
+		assert.Equal(t, "leaked", leakErr.Span.Name)
+		assert.Contains(t, err.Error(), `span "leaked" of scope "leaky"`)
+	case <-time.After(5 * time.Second):
+		t.Fatal("leaked span not reported")
+	}
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
@@ -0,0 +1,494 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
+)
+
+// Defaults for PersistentExporterOptions.
+const (
+	DefaultPersistentMaxBytes      = 64 << 20 // 64 MiB
+	DefaultPersistentMaxAge        = 24 * time.Hour
+	DefaultPersistentRetryInterval = 5 * time.Second
+)
+
+var errNilPersistentExporter = errors.New("nil SpanExporter")
+
+const (
+	spoolFileExt = ".wal"
+	spoolTmpExt  = ".tmp"
+)
+
+// PersistentExporterOption configures a PersistentExporter.
+type PersistentExporterOption func(o *PersistentExporterOptions)
+
+// PersistentExporterOptions is configuration settings for a
+// PersistentExporter.
+type PersistentExporterOptions struct {
+	// MaxBytes is the maximum total size of the spooled batches on disk.
+	// When the limit is exceeded, the oldest batches are dropped.
+	// The default value of MaxBytes is 64 MiB.
+	MaxBytes int64
+
+	// MaxAge is the maximum age of a spooled batch. Older batches are
+	// dropped instead of being exported.
+	// The default value of MaxAge is 24 hours.
+	MaxAge time.Duration
+
+	// RetryInterval is the interval at which the export of spooled batches
+	// is retried.
+	// The default value of RetryInterval is 5 seconds.
+	RetryInterval time.Duration
+}
+
+// WithPersistentMaxBytes returns a PersistentExporterOption that configures
+// the maximum total size of the spooled batches on disk.
+func WithPersistentMaxBytes(size int64) PersistentExporterOption {
+	return func(o *PersistentExporterOptions) {
+		o.MaxBytes = size
+	}
+}
+
+// WithPersistentMaxAge returns a PersistentExporterOption that configures
+// the maximum age of a spooled batch.
+func WithPersistentMaxAge(age time.Duration) PersistentExporterOption {
+	return func(o *PersistentExporterOptions) {
+		o.MaxAge = age
+	}
+}
+
+// WithPersistentRetryInterval returns a PersistentExporterOption that
+// configures the interval at which the export of spooled batches is retried.
+func WithPersistentRetryInterval(interval time.Duration) PersistentExporterOption {
+	return func(o *PersistentExporterOptions) {
+		o.RetryInterval = interval
+	}
+}
+
+// spoolEntry is a batch of spans written to the spool directory.
+type spoolEntry struct {
+	seq     uint64
+	spans   int
+	size    int64
+	created time.Time
+}
+
+func (e spoolEntry) fileName() string {
+	return fmt.Sprintf("%020d-%d%s", e.seq, e.spans, spoolFileExt)
+}
+
+// PersistentExporter is a SpanExporter that writes batches its wrapped
+// SpanExporter fails to export to a write-ahead log on disk. Spooled batches
+// are exported again, in the order they were spooled, before any new batch
+// once the wrapped SpanExporter recovers. Batches spooled and not exported
+// before the process stops are exported by the next PersistentExporter
+// using the same directory.
+//
+// The spool is bounded by size and age. Batches exceeding either bound are
+// dropped, oldest first.
+//
+// A PersistentExporter is meant to be wrapped by a span processor, e.g. the
+// BatchSpanProcessor, that batches spans. A directory must not be shared by
+// multiple PersistentExporters at the same time.
+type PersistentExporter struct {
+	exporter SpanExporter
+	dir      string
+	o        PersistentExporterOptions
+
+	// mu guards the spool. It is not held while spans are exported.
+	mu      sync.Mutex
+	entries []spoolEntry
+	size    int64
+	nextSeq uint64
+	stopped bool
+
+	dropped atomic.Uint64
+
+	// replayCh signals the retry loop to replay the spool without waiting
+	// for the next retry.
+	replayCh chan struct{}
+	stopCh   chan struct{}
+	stopWait sync.WaitGroup
+	stopOnce sync.Once
+}
+
+var _ SpanExporter = (*PersistentExporter)(nil)
+
+// NewPersistentExporter returns a PersistentExporter that exports spans with
+// exporter and spools the batches exporter fails to export in dir. The
+// directory is created if it does not exist, batches already spooled in it
+// are exported first.
+func NewPersistentExporter(exporter SpanExporter, dir string, options ...PersistentExporterOption) (*PersistentExporter, error) {
+	o := PersistentExporterOptions{
+		MaxBytes:      DefaultPersistentMaxBytes,
+		MaxAge:        DefaultPersistentMaxAge,
+		RetryInterval: DefaultPersistentRetryInterval,
+	}
+	for _, opt := range options {
+		opt(&o)
+	}
+	if exporter == nil {
+		return nil, errNilPersistentExporter
+	}
+	if o.RetryInterval <= 0 {
+		o.RetryInterval = DefaultPersistentRetryInterval
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	pe := &PersistentExporter{
+		exporter: exporter,
+		dir:      dir,
+		o:        o,
+		replayCh: make(chan struct{}, 1),
+		stopCh:   make(chan struct{}),
+	}
+	if err := pe.load(); err != nil {
+		return nil, err
+	}
+	pe.enforceLimits(time.Now())
+
+	pe.stopWait.Add(1)
+	go func() {
+		defer pe.stopWait.Done()
+		pe.retryLoop()
+	}()
+	return pe, nil
+}
+
+// load reads the batches spooled in the directory of pe.
+func (pe *PersistentExporter) load() error {
+	files, err := os.ReadDir(pe.dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		name := f.Name()
+		if strings.HasSuffix(name, spoolTmpExt) {
+			// Incomplete write of a previous process.
+			_ = os.Remove(filepath.Join(pe.dir, name))
+			continue
+		}
+		if f.IsDir() || !strings.HasSuffix(name, spoolFileExt) {
+			continue
+		}
+		var e spoolEntry
+		if _, err := fmt.Sscanf(name, "%d-%d"+spoolFileExt, &e.seq, &e.spans); err != nil {
+			global.Warn("ignoring unknown file in span spool directory", "file", name)
+			continue
+		}
+		info, err := f.Info()
+		if err != nil {
+			return err
+		}
+		e.size = info.Size()
+		e.created = info.ModTime()
+		pe.entries = append(pe.entries, e)
+		pe.size += e.size
+	}
+	sort.Slice(pe.entries, func(i, j int) bool { return pe.entries[i].seq < pe.entries[j].seq })
+	if n := len(pe.entries); n > 0 {
+		pe.nextSeq = pe.entries[n-1].seq + 1
+	}
+	return nil
+}
+
+// ExportSpans exports spans with the wrapped SpanExporter. If batches are
+// already spooled, spans are spooled behind them and exported in the
+// background, preserving the export order. If spans cannot be exported, they
+// are spooled to disk and nil is returned. An error is returned only if spans
+// could not be exported nor spooled.
+func (pe *PersistentExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	pe.mu.Lock()
+	if pe.stopped {
+		pe.mu.Unlock()
+		return nil
+	}
+	if len(pe.entries) > 0 {
+		err := pe.spool(spans)
+		pe.mu.Unlock()
+		pe.signalReplay()
+		return err
+	}
+	pe.mu.Unlock()
+
+	err := pe.exporter.ExportSpans(ctx, spans)
+	if err == nil {
+		return nil
+	}
+
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	if spoolErr := pe.spool(spans); spoolErr != nil {
+		return errors.Join(err, spoolErr)
+	}
+	global.Debug("spooled spans after failed export", "count", len(spans), "error", err)
+	return nil
+}
+
+// replay exports the spooled batches, oldest first. It stops at the first
+// batch that fails to be exported and returns the export error.
+//
+// pe.mu is only held to access the spool, not while the batches are
+// exported. replay is only called by the retry loop, or once it returned, so
+// replays never overlap.
+func (pe *PersistentExporter) replay(ctx context.Context) error {
+	for {
+		pe.mu.Lock()
+		pe.enforceLimits(time.Now())
+		if len(pe.entries) == 0 {
+			pe.mu.Unlock()
+			return nil
+		}
+		e := pe.entries[0]
+		pe.mu.Unlock()
+
+		path := filepath.Join(pe.dir, e.fileName())
+		data, err := os.ReadFile(path)
+		var spans []ReadOnlySpan
+		if err == nil {
+			spans, err = decodeSpans(data)
+		}
+		if err != nil {
+			pe.mu.Lock()
+			// The batch may have been dropped by the spool limits meanwhile.
+			if pe.removeSeq(e.seq) {
+				otel.Handle(fmt.Errorf("dropping unreadable spooled spans %s: %w", path, err))
+				pe.dropped.Add(uint64(e.spans))
+			}
+			pe.mu.Unlock()
+			continue
+		}
+		if err := pe.exporter.ExportSpans(ctx, spans); err != nil {
+			return err
+		}
+		pe.mu.Lock()
+		pe.removeSeq(e.seq)
+		pe.mu.Unlock()
+	}
+}
+
+// signalReplay signals the retry loop to replay the spool.
+func (pe *PersistentExporter) signalReplay() {
+	select {
+	case pe.replayCh <- struct{}{}:
+	default:
+	}
+}
+
+// spool writes spans to the spool directory.
+//
+// pe.mu must be held when called.
+func (pe *PersistentExporter) spool(spans []ReadOnlySpan) error {
+	data, err := encodeSpans(spans)
+	if err != nil {
+		return err
+	}
+	e := spoolEntry{
+		seq:     pe.nextSeq,
+		spans:   len(spans),
+		size:    int64(len(data)),
+		created: time.Now(),
+	}
+	path := filepath.Join(pe.dir, e.fileName())
+	// Write to a temporary file first so a partially written batch is never
+	// read back.
+	tmp := path + spoolTmpExt
+	if err := writeFileSync(tmp, data); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	// Persist the rename so the batch survives a crash of the host.
+	if err := syncDir(pe.dir); err != nil {
+		otel.Handle(fmt.Errorf("failed to sync span spool directory %s: %w", pe.dir, err))
+	}
+	pe.nextSeq++
+	pe.entries = append(pe.entries, e)
+	pe.size += e.size
+	pe.enforceLimits(e.created)
+	return nil
+}
+
+// writeFileSync writes data to the file named name and flushes it to stable
+// storage.
+func writeFileSync(name string, data []byte) error {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// syncDir flushes the entries of the directory dir to stable storage.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if closeErr := d.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// enforceLimits drops the spooled batches older than the maximum age and the
+// oldest batches exceeding the maximum size.
+//
+// pe.mu must be held when called.
+func (pe *PersistentExporter) enforceLimits(now time.Time) {
+	for len(pe.entries) > 0 {
+		e := pe.entries[0]
+		expired := pe.o.MaxAge > 0 && now.Sub(e.created) > pe.o.MaxAge
+		oversize := pe.o.MaxBytes > 0 && pe.size > pe.o.MaxBytes
+		if !expired && !oversize {
+			return
+		}
+		pe.dropped.Add(uint64(e.spans))
+		pe.remove(0)
+	}
+}
+
+// remove deletes the spooled batch i.
+//
+// pe.mu must be held when called.
+func (pe *PersistentExporter) remove(i int) {
+	e := pe.entries[i]
+	if err := os.Remove(filepath.Join(pe.dir, e.fileName())); err != nil && !errors.Is(err, os.ErrNotExist) {
+		otel.Handle(err)
+	}
+	pe.size -= e.size
+	pe.entries = append(pe.entries[:i], pe.entries[i+1:]...)
+}
+
+// removeSeq deletes the spooled batch with the sequence number seq. It
+// returns false if the batch is not spooled anymore.
+//
+// pe.mu must be held when called.
+func (pe *PersistentExporter) removeSeq(seq uint64) bool {
+	for i, e := range pe.entries {
+		if e.seq == seq {
+			pe.remove(i)
+			return true
+		}
+	}
+	return false
+}
+
+func (pe *PersistentExporter) retryLoop() {
+	ticker := time.NewTicker(pe.o.RetryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-pe.stopCh:
+			return
+		case <-ticker.C:
+			pe.retry()
+		case <-pe.replayCh:
+			pe.retry()
+		}
+	}
+}
+
+func (pe *PersistentExporter) retry() {
+	ctx, cancel := context.WithTimeout(context.Background(), pe.o.RetryInterval)
+	defer cancel()
+	if err := pe.replay(ctx); err != nil {
+		global.Debug("failed to export spooled spans", "pending", pe.Pending(), "error", err)
+	}
+}
+
+// Pending returns the number of spans spooled to disk waiting to be
+// exported.
+func (pe *PersistentExporter) Pending() int {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	var n int
+	for _, e := range pe.entries {
+		n += e.spans
+	}
+	return n
+}
+
+// DroppedSpans returns the number of spooled spans dropped because they
+// exceeded the size or age limits of the spool, or could not be read back.
+func (pe *PersistentExporter) DroppedSpans() uint64 {
+	return pe.dropped.Load()
+}
+
+// Shutdown stops the retries of pe, makes a last attempt to export the
+// spooled batches and shuts down the wrapped SpanExporter. Batches that are
+// still not exported remain on disk to be exported by the next
+// PersistentExporter using the same directory.
+func (pe *PersistentExporter) Shutdown(ctx context.Context) error {
+	var err error
+	pe.stopOnce.Do(func() {
+		var shutdownErr error
+		wait := make(chan struct{})
+		go func() {
+			close(pe.stopCh)
+			pe.stopWait.Wait()
+
+			if replayErr := pe.replay(ctx); replayErr != nil {
+				global.Debug("spooled spans remain on disk", "pending", pe.Pending(), "error", replayErr)
+			}
+			pe.mu.Lock()
+			pe.stopped = true
+			pe.mu.Unlock()
+
+			shutdownErr = pe.exporter.Shutdown(ctx)
+			close(wait)
+		}()
+		select {
+		case <-wait:
+			err = shutdownErr
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	})
+	return err
+}
+
+// MarshalLog is the marshaling function used by the logging system to represent this Exporter.
+func (pe *PersistentExporter) MarshalLog() interface{} {
+	return struct {
+		Type     string
+		Exporter SpanExporter
+		Dir      string
+		Config   PersistentExporterOptions
+	}{
+		Type:     "PersistentExporter",
+		Exporter: pe.exporter,
+		Dir:      pe.dir,
+		Config:   pe.o,
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// spooledSpan is the serialized form of a ReadOnlySpan written to the spool
+// of a PersistentExporter.
+type spooledSpan struct {
+	Name                  string                `json:"name"`
+	SpanContext           spooledSpanContext    `json:"spanContext"`
+	Parent                spooledSpanContext    `json:"parent"`
+	SpanKind              trace.SpanKind        `json:"spanKind"`
+	StartTime             time.Time             `json:"startTime"`
+	EndTime               time.Time             `json:"endTime"`
+	Attributes            []spooledAttribute    `json:"attributes,omitempty"`
+	Events                []spooledEvent        `json:"events,omitempty"`
+	Links                 []spooledLink         `json:"links,omitempty"`
+	StatusCode            uint32                `json:"statusCode,omitempty"`
+	StatusDescription     string                `json:"statusDescription,omitempty"`
+	ChildSpanCount        int                   `json:"childSpanCount,omitempty"`
+	DroppedAttributeCount int                   `json:"droppedAttributeCount,omitempty"`
+	DroppedEventCount     int                   `json:"droppedEventCount,omitempty"`
+	DroppedLinkCount      int                   `json:"droppedLinkCount,omitempty"`
+	Resource              spooledResource       `json:"resource"`
+	Scope                 instrumentation.Scope `json:"scope"`
+}
+
+type spooledSpanContext struct {
+	TraceID    string `json:"traceID,omitempty"`
+	SpanID     string `json:"spanID,omitempty"`
+	TraceFlags byte   `json:"traceFlags,omitempty"`
+	TraceState string `json:"traceState,omitempty"`
+	Remote     bool   `json:"remote,omitempty"`
+}
+
+type spooledAttribute struct {
+	Key   string          `json:"key"`
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+type spooledEvent struct {
+	Name                  string             `json:"name"`
+	Time                  time.Time          `json:"time"`
+	Attributes            []spooledAttribute `json:"attributes,omitempty"`
+	DroppedAttributeCount int                `json:"droppedAttributeCount,omitempty"`
+}
+
+type spooledLink struct {
+	SpanContext           spooledSpanContext `json:"spanContext"`
+	Attributes            []spooledAttribute `json:"attributes,omitempty"`
+	DroppedAttributeCount int                `json:"droppedAttributeCount,omitempty"`
+}
+
+type spooledResource struct {
+	SchemaURL  string             `json:"schemaURL,omitempty"`
+	Attributes []spooledAttribute `json:"attributes,omitempty"`
+}
+
+// encodeSpans returns the serialized form of spans.
+func encodeSpans(spans []ReadOnlySpan) ([]byte, error) {
+	out := make([]spooledSpan, len(spans))
+	for i, s := range spans {
+		ss := spooledSpan{
+			Name:                  s.Name(),
+			SpanContext:           encodeSpanContext(s.SpanContext()),
+			Parent:                encodeSpanContext(s.Parent()),
+			SpanKind:              s.SpanKind(),
+			StartTime:             s.StartTime(),
+			EndTime:               s.EndTime(),
+			Attributes:            encodeAttributes(s.Attributes()),
+			StatusCode:            uint32(s.Status().Code),
+			StatusDescription:     s.Status().Description,
+			ChildSpanCount:        s.ChildSpanCount(),
+			DroppedAttributeCount: s.DroppedAttributes(),
+			DroppedEventCount:     s.DroppedEvents(),
+			DroppedLinkCount:      s.DroppedLinks(),
+			Scope:                 s.InstrumentationScope(),
+		}
+		for _, e := range s.Events() {
+			ss.Events = append(ss.Events, spooledEvent{
+				Name:                  e.Name,
+				Time:                  e.Time,
+				Attributes:            encodeAttributes(e.Attributes),
+				DroppedAttributeCount: e.DroppedAttributeCount,
+			})
+		}
+		for _, l := range s.Links() {
+			ss.Links = append(ss.Links, spooledLink{
+				SpanContext:           encodeSpanContext(l.SpanContext),
+				Attributes:            encodeAttributes(l.Attributes),
+				DroppedAttributeCount: l.DroppedAttributeCount,
+			})
+		}
+		if res := s.Resource(); res != nil {
+			ss.Resource = spooledResource{
+				SchemaURL:  res.SchemaURL(),
+				Attributes: encodeAttributes(res.Attributes()),
+			}
+		}
+		out[i] = ss
+	}
+	return json.Marshal(out)
+}
+
+// decodeSpans returns the spans serialized in data by encodeSpans.
+func decodeSpans(data []byte) ([]ReadOnlySpan, error) {
+	var in []spooledSpan
+	if err := json.Unmarshal(data, &in); err != nil {
+		return nil, err
+	}
+
+	spans := make([]ReadOnlySpan, len(in))
+	for i, ss := range in {
+		s := snapshot{
+			name:                  ss.Name,
+			spanKind:              ss.SpanKind,
+			startTime:             ss.StartTime,
+			endTime:               ss.EndTime,
+			status:                Status{Code: codes.Code(ss.StatusCode), Description: ss.StatusDescription},
+			childSpanCount:        ss.ChildSpanCount,
+			droppedAttributeCount: ss.DroppedAttributeCount,
+			droppedEventCount:     ss.DroppedEventCount,
+			droppedLinkCount:      ss.DroppedLinkCount,
+			instrumentationScope:  ss.Scope,
+		}
+
+		var err error
+		if s.spanContext, err = decodeSpanContext(ss.SpanContext); err != nil {
+			return nil, err
+		}
+		if s.parent, err = decodeSpanContext(ss.Parent); err != nil {
+			return nil, err
+		}
+		if s.attributes, err = decodeAttributes(ss.Attributes); err != nil {
+			return nil, err
+		}
+		for _, se := range ss.Events {
+			e := Event{Name: se.Name, Time: se.Time, DroppedAttributeCount: se.DroppedAttributeCount}
+			if e.Attributes, err = decodeAttributes(se.Attributes); err != nil {
+				return nil, err
+			}
+			s.events = append(s.events, e)
+		}
+		for _, sl := range ss.Links {
+			l := Link{DroppedAttributeCount: sl.DroppedAttributeCount}
+			if l.SpanContext, err = decodeSpanContext(sl.SpanContext); err != nil {
+				return nil, err
+			}
+			if l.Attributes, err = decodeAttributes(sl.Attributes); err != nil {
+				return nil, err
+			}
+			s.links = append(s.links, l)
+		}
+		resAttrs, err := decodeAttributes(ss.Resource.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		s.resource = resource.NewWithAttributes(ss.Resource.SchemaURL, resAttrs...)
+
+		spans[i] = s
+	}
+	return spans, nil
+}
+
+func encodeSpanContext(sc trace.SpanContext) spooledSpanContext {
+	if !sc.IsValid() {
+		return spooledSpanContext{}
+	}
+	tid, sid := sc.TraceID(), sc.SpanID()
+	return spooledSpanContext{
+		TraceID:    hex.EncodeToString(tid[:]),
+		SpanID:     hex.EncodeToString(sid[:]),
+		TraceFlags: byte(sc.TraceFlags()),
+		TraceState: sc.TraceState().String(),
+		Remote:     sc.IsRemote(),
+	}
+}
+
+func decodeSpanContext(ssc spooledSpanContext) (trace.SpanContext, error) {
+	if ssc.TraceID == "" && ssc.SpanID == "" {
+		return trace.SpanContext{}, nil
+	}
+
+	var cfg trace.SpanContextConfig
+	if err := decodeHex(cfg.TraceID[:], ssc.TraceID); err != nil {
+		return trace.SpanContext{}, fmt.Errorf("invalid trace ID %q: %w", ssc.TraceID, err)
+	}
+	if err := decodeHex(cfg.SpanID[:], ssc.SpanID); err != nil {
+		return trace.SpanContext{}, fmt.Errorf("invalid span ID %q: %w", ssc.SpanID, err)
+	}
+	ts, err := trace.ParseTraceState(ssc.TraceState)
+	if err != nil {
+		return trace.SpanContext{}, err
+	}
+	cfg.TraceFlags = trace.TraceFlags(ssc.TraceFlags)
+	cfg.TraceState = ts
+	cfg.Remote = ssc.Remote
+	return trace.NewSpanContext(cfg), nil
+}
+
+// decodeHex decodes the hex string s into dst. It returns an error if s does
+// not decode to exactly len(dst) bytes.
+func decodeHex(dst []byte, s string) error {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	if len(b) != len(dst) {
+		return fmt.Errorf("decoded %d bytes, want %d", len(b), len(dst))
+	}
+	copy(dst, b)
+	return nil
+}
+
+func encodeAttributes(attrs []attribute.KeyValue) []spooledAttribute {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]spooledAttribute, 0, len(attrs))
+	for _, kv := range attrs {
+		v, err := json.Marshal(encodableValue(kv.Value))
+		if err != nil {
+			// Only values of invalid attribute types fail to encode, skip
+			// the attribute.
+			continue
+		}
+		out = append(out, spooledAttribute{
+			Key:   string(kv.Key),
+			Type:  kv.Value.Type().String(),
+			Value: v,
+		})
+	}
+	return out
+}
+
+// encodableValue returns the JSON encodable form of v. Floats are encoded as
+// spooledFloat so NaN and infinite values are encoded losslessly.
+func encodableValue(v attribute.Value) interface{} {
+	switch v.Type() {
+	case attribute.FLOAT64:
+		return spooledFloat(v.AsFloat64())
+	case attribute.FLOAT64SLICE:
+		floats := v.AsFloat64Slice()
+		out := make([]spooledFloat, len(floats))
+		for i, f := range floats {
+			out[i] = spooledFloat(f)
+		}
+		return out
+	default:
+		return v.AsInterface()
+	}
+}
+
+// spooledFloat is a float64 that encodes NaN and infinite values, which JSON
+// numbers cannot represent, as the "NaN", "+Inf", and "-Inf" strings.
+type spooledFloat float64
+
+func (f spooledFloat) MarshalJSON() ([]byte, error) {
+	switch v := float64(f); {
+	case math.IsNaN(v):
+		return []byte(`"NaN"`), nil
+	case math.IsInf(v, 1):
+		return []byte(`"+Inf"`), nil
+	case math.IsInf(v, -1):
+		return []byte(`"-Inf"`), nil
+	default:
+		return json.Marshal(v)
+	}
+}
+
+func (f *spooledFloat) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var v float64
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		*f = spooledFloat(v)
+		return nil
+	}
+	switch s {
+	case "NaN":
+		*f = spooledFloat(math.NaN())
+	case "+Inf":
+		*f = spooledFloat(math.Inf(1))
+	case "-Inf":
+		*f = spooledFloat(math.Inf(-1))
+	default:
+		return fmt.Errorf("invalid float %q", s)
+	}
+	return nil
+}
+
+func decodeAttributes(in []spooledAttribute) ([]attribute.KeyValue, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make([]attribute.KeyValue, len(in))
+	for i, sa := range in {
+		v, err := decodeValue(sa.Type, sa.Value)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", sa.Key, err)
+		}
+		out[i] = attribute.KeyValue{Key: attribute.Key(sa.Key), Value: v}
+	}
+	return out, nil
+}
+
+func decodeValue(typ string, data json.RawMessage) (attribute.Value, error) {
+	var err error
+	switch typ {
+	case attribute.BOOL.String():
+		var v bool
+		err = json.Unmarshal(data, &v)
+		return attribute.BoolValue(v), err
+	case attribute.INT64.String():
+		var v int64
+		err = json.Unmarshal(data, &v)
+		return attribute.Int64Value(v), err
+	case attribute.FLOAT64.String():
+		var v spooledFloat
+		err = json.Unmarshal(data, &v)
+		return attribute.Float64Value(float64(v)), err
+	case attribute.STRING.String():
+		var v string
+		err = json.Unmarshal(data, &v)
+		return attribute.StringValue(v), err
+	case attribute.BOOLSLICE.String():
+		var v []bool
+		err = json.Unmarshal(data, &v)
+		return attribute.BoolSliceValue(v), err
+	case attribute.INT64SLICE.String():
+		var v []int64
+		err = json.Unmarshal(data, &v)
+		return attribute.Int64SliceValue(v), err
+	case attribute.FLOAT64SLICE.String():
+		var v []spooledFloat
+		err = json.Unmarshal(data, &v)
+		floats := make([]float64, len(v))
+		for i, f := range v {
+			floats[i] = float64(f)
+		}
+		return attribute.Float64SliceValue(floats), err
+	case attribute.STRINGSLICE.String():
+		var v []string
+		err = json.Unmarshal(data, &v)
+		return attribute.StringSliceValue(v), err
+	default:
+		return attribute.Value{}, fmt.Errorf("unknown attribute type %q", typ)
+	}
+}
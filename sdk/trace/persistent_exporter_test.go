@@ -0,0 +1,343 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errCollectorDown = errors.New("collector down")
+
+// flakyExporter is a SpanExporter that fails while it is down.
+type flakyExporter struct {
+	mu       sync.Mutex
+	down     bool
+	exported []string
+	shutdown int
+}
+
+func (e *flakyExporter) setDown(down bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.down = down
+}
+
+func (e *flakyExporter) names() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.exported...)
+}
+
+func (e *flakyExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.down {
+		return errCollectorDown
+	}
+	for _, s := range spans {
+		e.exported = append(e.exported, s.Name())
+	}
+	return nil
+}
+
+func (e *flakyExporter) Shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.shutdown++
+	return nil
+}
+
+func namedSpans(names ...string) []sdktrace.ReadOnlySpan {
+	stubs := make(tracetest.SpanStubs, len(names))
+	for i, n := range names {
+		stubs[i] = tracetest.SpanStub{Name: n}
+	}
+	return stubs.Snapshots()
+}
+
+func newPersistentExporter(t *testing.T, exp sdktrace.SpanExporter, dir string, opts ...sdktrace.PersistentExporterOption) *sdktrace.PersistentExporter {
+	opts = append([]sdktrace.PersistentExporterOption{sdktrace.WithPersistentRetryInterval(time.Hour)}, opts...)
+	pe, err := sdktrace.NewPersistentExporter(exp, dir, opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = pe.Shutdown(context.Background()) })
+	return pe
+}
+
+func TestPersistentExporterReplaysInOrder(t *testing.T) {
+	ctx := context.Background()
+	exp := &flakyExporter{}
+	pe := newPersistentExporter(t, exp, t.TempDir())
+
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("a", "b")))
+	exp.setDown(true)
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("c")))
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("d", "e")))
+	assert.Equal(t, 3, pe.Pending())
+	assert.Equal(t, []string{"a", "b"}, exp.names())
+
+	exp.setDown(false)
+	// Spans exported with a backlog are spooled behind it and replayed in
+	// the background.
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("f")))
+	assert.Eventually(t, func() bool {
+		return pe.Pending() == 0
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, exp.names())
+
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("g")))
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f", "g"}, exp.names())
+}
+
+func TestPersistentExporterRetry(t *testing.T) {
+	exp := &flakyExporter{down: true}
+	pe := newPersistentExporter(t, exp, t.TempDir(), sdktrace.WithPersistentRetryInterval(5*time.Millisecond))
+
+	require.NoError(t, pe.ExportSpans(context.Background(), namedSpans("a")))
+	assert.Equal(t, 1, pe.Pending())
+
+	exp.setDown(false)
+	assert.Eventually(t, func() bool {
+		return pe.Pending() == 0
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"a"}, exp.names())
+}
+
+func TestPersistentExporterSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	exp := &flakyExporter{down: true}
+	pe := newPersistentExporter(t, exp, dir)
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("a", "b")))
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("c")))
+	require.NoError(t, pe.Shutdown(ctx))
+	assert.Equal(t, 1, exp.shutdown)
+
+	// A partially written batch of the stopped process is discarded.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "partial.wal.tmp"), []byte("{"), 0o600))
+
+	exp = &flakyExporter{}
+	pe = newPersistentExporter(t, exp, dir)
+	assert.Equal(t, 3, pe.Pending())
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("d")))
+	assert.Eventually(t, func() bool {
+		return pe.Pending() == 0
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, exp.names())
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestPersistentExporterShutdownReplays(t *testing.T) {
+	ctx := context.Background()
+	exp := &flakyExporter{down: true}
+	pe := newPersistentExporter(t, exp, t.TempDir())
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("a")))
+
+	exp.setDown(false)
+	require.NoError(t, pe.Shutdown(ctx))
+	assert.Equal(t, []string{"a"}, exp.names())
+
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("b")))
+	assert.Equal(t, []string{"a"}, exp.names(), "spans exported after shutdown")
+}
+
+func TestPersistentExporterMaxBytes(t *testing.T) {
+	ctx := context.Background()
+	exp := &flakyExporter{down: true}
+	dir := t.TempDir()
+
+	// Measure the size of a single spooled batch.
+	probeDir := t.TempDir()
+	probe := newPersistentExporter(t, exp, probeDir)
+	require.NoError(t, probe.ExportSpans(ctx, namedSpans("a")))
+	files, err := os.ReadDir(probeDir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	info, err := files[0].Info()
+	require.NoError(t, err)
+	size := info.Size()
+	require.Positive(t, size)
+
+	pe := newPersistentExporter(t, exp, dir, sdktrace.WithPersistentMaxBytes(2*size))
+	for _, name := range []string{"a", "b", "c"} {
+		require.NoError(t, pe.ExportSpans(ctx, namedSpans(name)))
+	}
+	assert.Equal(t, 2, pe.Pending())
+	assert.Equal(t, uint64(1), pe.DroppedSpans())
+
+	exp.setDown(false)
+	require.NoError(t, pe.Shutdown(ctx))
+	assert.Equal(t, []string{"b", "c"}, exp.names(), "oldest batch should be evicted")
+}
+
+func TestPersistentExporterMaxAge(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	exp := &flakyExporter{down: true}
+	pe := newPersistentExporter(t, exp, dir)
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("old")))
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("new")))
+	require.NoError(t, pe.Shutdown(ctx))
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	old := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, files[0].Name()), old, old))
+
+	exp = &flakyExporter{}
+	pe = newPersistentExporter(t, exp, dir, sdktrace.WithPersistentMaxAge(time.Hour))
+	assert.Equal(t, 1, pe.Pending())
+	assert.Equal(t, uint64(1), pe.DroppedSpans())
+	require.NoError(t, pe.ExportSpans(ctx, namedSpans("next")))
+	require.NoError(t, pe.Shutdown(ctx))
+	assert.Equal(t, []string{"new", "next"}, exp.names())
+}
+
+func TestPersistentExporterSpanFidelity(t *testing.T) {
+	ctx := context.Background()
+	tid := trace.TraceID{0x01, 0x02}
+	ts, err := trace.ParseTraceState("k=v")
+	require.NoError(t, err)
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    tid,
+		SpanID:     trace.SpanID{0x03},
+		TraceFlags: trace.FlagsSampled,
+		TraceState: ts,
+	})
+	parent := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: tid,
+		SpanID:  trace.SpanID{0x04},
+		Remote:  true,
+	})
+	start := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	stub := tracetest.SpanStub{
+		Name:        "span",
+		SpanContext: sc,
+		Parent:      parent,
+		SpanKind:    trace.SpanKindServer,
+		StartTime:   start,
+		EndTime:     start.Add(time.Second),
+		Attributes: []attribute.KeyValue{
+			attribute.Bool("bool", true),
+			attribute.Int64("int", 1<<62+1),
+			attribute.Float64("float", 1.5),
+			attribute.String("string", "s"),
+			attribute.BoolSlice("bools", []bool{true, false}),
+			attribute.Int64Slice("ints", []int64{1, 2}),
+			attribute.Float64Slice("floats", []float64{1.5, 2.5}),
+			attribute.StringSlice("strings", []string{"a", "b"}),
+		},
+		Events: []sdktrace.Event{{
+			Name:                  "event",
+			Attributes:            []attribute.KeyValue{attribute.String("k", "v")},
+			DroppedAttributeCount: 1,
+			Time:                  start.Add(time.Millisecond),
+		}},
+		Links: []sdktrace.Link{{
+			SpanContext:           parent,
+			Attributes:            []attribute.KeyValue{attribute.Int("n", 1)},
+			DroppedAttributeCount: 2,
+		}},
+		Status:                 sdktrace.Status{Code: codes.Error, Description: "failed"},
+		DroppedAttributes:      3,
+		DroppedEvents:          4,
+		DroppedLinks:           5,
+		ChildSpanCount:         6,
+		Resource:               resource.NewWithAttributes("https://example.com/schema", attribute.String("service.name", "svc")),
+		InstrumentationLibrary: instrumentation.Scope{Name: "scope", Version: "v1", SchemaURL: "https://example.com/scope"},
+	}
+
+	dir := t.TempDir()
+	pe := newPersistentExporter(t, &flakyExporter{down: true}, dir)
+	require.NoError(t, pe.ExportSpans(ctx, tracetest.SpanStubs{stub}.Snapshots()))
+	require.NoError(t, pe.Shutdown(ctx))
+
+	exp := tracetest.NewInMemoryExporter()
+	newPersistentExporter(t, exp, dir, sdktrace.WithPersistentRetryInterval(5*time.Millisecond))
+	assert.Eventually(t, func() bool {
+		return len(exp.GetSpans()) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	got := exp.GetSpans()
+	require.Len(t, got, 1)
+	assert.Equal(t, stub, got[0])
+}
+
+func TestPersistentExporterNonFiniteFloats(t *testing.T) {
+	ctx := context.Background()
+	attrs := []attribute.KeyValue{
+		attribute.Float64("nan", math.NaN()),
+		attribute.Float64("inf", math.Inf(1)),
+		attribute.Float64Slice("floats", []float64{math.Inf(-1), 0.5, math.NaN()}),
+	}
+
+	dir := t.TempDir()
+	pe := newPersistentExporter(t, &flakyExporter{down: true}, dir)
+	stubs := tracetest.SpanStubs{{Name: "span", Attributes: attrs}}
+	require.NoError(t, pe.ExportSpans(ctx, stubs.Snapshots()))
+	require.NoError(t, pe.Shutdown(ctx))
+
+	exp := tracetest.NewInMemoryExporter()
+	newPersistentExporter(t, exp, dir, sdktrace.WithPersistentRetryInterval(5*time.Millisecond))
+	assert.Eventually(t, func() bool {
+		return len(exp.GetSpans()) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	got := exp.GetSpans()
+	require.Len(t, got, 1)
+	require.Len(t, got[0].Attributes, len(attrs))
+	assert.True(t, math.IsNaN(got[0].Attributes[0].Value.AsFloat64()))
+	assert.Equal(t, attrs[1], got[0].Attributes[1])
+	floats := got[0].Attributes[2].Value.AsFloat64Slice()
+	require.Len(t, floats, 3)
+	assert.Equal(t, []float64{math.Inf(-1), 0.5}, floats[:2])
+	assert.True(t, math.IsNaN(floats[2]))
+}
+
+func TestPersistentExporterNilExporter(t *testing.T) {
+	_, err := sdktrace.NewPersistentExporter(nil, t.TempDir())
+	assert.Error(t, err)
+}
+
+func TestPersistentExporterWithBatchSpanProcessor(t *testing.T) {
+	exp := &flakyExporter{down: true}
+	pe := newPersistentExporter(t, exp, t.TempDir())
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(pe))
+	tr := tp.Tracer("TestPersistentExporterWithBatchSpanProcessor")
+
+	_, span := tr.Start(context.Background(), "a")
+	span.End()
+	require.NoError(t, tp.ForceFlush(context.Background()))
+	assert.Equal(t, 1, pe.Pending())
+
+	exp.setDown(false)
+	_, span = tr.Start(context.Background(), "b")
+	span.End()
+	require.NoError(t, tp.Shutdown(context.Background()))
+	assert.Equal(t, []string{"a", "b"}, exp.names())
+	assert.Equal(t, 1, exp.shutdown)
+}
@@ -0,0 +1,212 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"container/list"
+	"strconv"
+	"sync"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanPriority is the priority class of a span in the queue of a
+// BatchSpanProcessor configured with WithPriorityQueue. When the queue is
+// full, spans of a lower priority are dropped first.
+type SpanPriority int
+
+// Priority classes of spans.
+const (
+	// SpanPriorityLow is the priority of spans dropped first.
+	SpanPriorityLow SpanPriority = iota
+	// SpanPriorityHigh is the priority of spans kept over low priority
+	// spans.
+	SpanPriorityHigh
+)
+
+// String returns the name of the priority class p.
+func (p SpanPriority) String() string {
+	switch p {
+	case SpanPriorityLow:
+		return "low"
+	case SpanPriorityHigh:
+		return "high"
+	default:
+		return strconv.Itoa(int(p))
+	}
+}
+
+// DefaultSpanPriority returns SpanPriorityHigh for spans with an Error status
+// and for server spans that are the local root of their trace, and
+// SpanPriorityLow for all other spans.
+func DefaultSpanPriority(s ReadOnlySpan) SpanPriority {
+	if s.Status().Code == codes.Error {
+		return SpanPriorityHigh
+	}
+	isLocalRoot := !s.Parent().IsValid() || s.Parent().IsRemote()
+	if isLocalRoot && s.SpanKind() == trace.SpanKindServer {
+		return SpanPriorityHigh
+	}
+	return SpanPriorityLow
+}
+
+// priorityQueue is a bounded queue of spans that drops the oldest span of the
+// lowest priority class when a span is pushed while it is full. Spans are
+// popped in the order they were pushed.
+type priorityQueue struct {
+	priority func(ReadOnlySpan) SpanPriority
+	capacity int
+
+	// ready is signaled when an item is pushed.
+	ready chan struct{}
+
+	mu sync.Mutex
+	// order holds the queued items, a *priorityItem, in push order.
+	order *list.List
+	// classes holds the elements of order of each priority class, in push
+	// order.
+	classes map[SpanPriority]*list.List
+	// size is the number of queued spans, flush requests excluded.
+	size    int
+	dropped map[SpanPriority]uint64
+}
+
+type priorityItem struct {
+	span     ReadOnlySpan
+	priority SpanPriority
+	flush    bool
+}
+
+func newPriorityQueue(capacity int, priority func(ReadOnlySpan) SpanPriority) *priorityQueue {
+	if priority == nil {
+		priority = DefaultSpanPriority
+	}
+	return &priorityQueue{
+		priority: priority,
+		capacity: capacity,
+		ready:    make(chan struct{}, 1),
+		order:    list.New(),
+		classes:  make(map[SpanPriority]*list.List),
+		dropped:  make(map[SpanPriority]uint64),
+	}
+}
+
+// push queues s. If the queue is full, the oldest queued span of the lowest
+// priority class is dropped and its priority is returned. If no span is
+// queued with a priority lower than or equal to the one of s, s is dropped
+// instead.
+func (q *priorityQueue) push(s ReadOnlySpan) (dropped bool, droppedPriority SpanPriority) {
+	p := q.priority(s)
+
+	q.mu.Lock()
+	if q.size >= q.capacity {
+		lowest, ok := q.lowestClass()
+		if !ok || lowest > p {
+			// s has a lower priority than all queued spans, drop it.
+			q.dropped[p]++
+			q.mu.Unlock()
+			return true, p
+		}
+		q.remove(q.classes[lowest].Front().Value.(*list.Element))
+		q.dropped[lowest]++
+		dropped, droppedPriority = true, lowest
+	}
+	q.add(&priorityItem{span: s, priority: p})
+	q.mu.Unlock()
+
+	q.signal()
+	return dropped, droppedPriority
+}
+
+// pushFlush queues a flush request, regardless of the capacity of the queue.
+// A flush request is never dropped.
+func (q *priorityQueue) pushFlush(ffs forceFlushSpan) {
+	q.mu.Lock()
+	q.order.PushBack(&priorityItem{span: ffs, flush: true})
+	q.mu.Unlock()
+
+	q.signal()
+}
+
+func (q *priorityQueue) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// pop returns the oldest queued item, if any.
+func (q *priorityQueue) pop() (ReadOnlySpan, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	e := q.order.Front()
+	if e == nil {
+		return nil, false
+	}
+	item := e.Value.(*priorityItem)
+	if item.flush {
+		q.order.Remove(e)
+	} else {
+		q.remove(e)
+	}
+	return item.span, true
+}
+
+// len returns the number of queued spans.
+func (q *priorityQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.size
+}
+
+// droppedByPriority returns the number of spans dropped per priority class.
+func (q *priorityQueue) droppedByPriority() map[SpanPriority]uint64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	out := make(map[SpanPriority]uint64, len(q.dropped))
+	for p, n := range q.dropped {
+		out[p] = n
+	}
+	return out
+}
+
+// lowestClass returns the lowest priority class with queued spans.
+//
+// This method assumes q.mu is held by the caller.
+func (q *priorityQueue) lowestClass() (SpanPriority, bool) {
+	var (
+		lowest SpanPriority
+		found  bool
+	)
+	for p, l := range q.classes {
+		if l.Len() > 0 && (!found || p < lowest) {
+			lowest, found = p, true
+		}
+	}
+	return lowest, found
+}
+
+// add queues item.
+//
+// This method assumes q.mu is held by the caller.
+func (q *priorityQueue) add(item *priorityItem) {
+	l, ok := q.classes[item.priority]
+	if !ok {
+		l = list.New()
+		q.classes[item.priority] = l
+	}
+	l.PushBack(q.order.PushBack(item))
+	q.size++
+}
+
+// remove removes e, the oldest queued span of its priority class.
+//
+// This method assumes q.mu is held by the caller.
+func (q *priorityQueue) remove(e *list.Element) {
+	item := q.order.Remove(e).(*priorityItem)
+	l := q.classes[item.priority]
+	l.Remove(l.Front())
+	q.size--
+}
@@ -0,0 +1,211 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestDefaultSpanPriority(t *testing.T) {
+	remote := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+		Remote:  true,
+	})
+	local := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+
+	tests := []struct {
+		name string
+		span *snapshot
+		want SpanPriority
+	}{
+		{"Internal", &snapshot{spanKind: trace.SpanKindInternal}, SpanPriorityLow},
+		{"Error", &snapshot{status: Status{Code: codes.Error}}, SpanPriorityHigh},
+		{"ServerRoot", &snapshot{spanKind: trace.SpanKindServer}, SpanPriorityHigh},
+		{"ServerRemoteParent", &snapshot{spanKind: trace.SpanKindServer, parent: remote}, SpanPriorityHigh},
+		{"ServerLocalParent", &snapshot{spanKind: trace.SpanKindServer, parent: local}, SpanPriorityLow},
+		{"ClientRoot", &snapshot{spanKind: trace.SpanKindClient}, SpanPriorityLow},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, DefaultSpanPriority(test.span))
+		})
+	}
+}
+
+func TestSpanPriorityString(t *testing.T) {
+	assert.Equal(t, "low", SpanPriorityLow.String())
+	assert.Equal(t, "high", SpanPriorityHigh.String())
+	assert.Equal(t, "5", SpanPriority(5).String())
+}
+
+func popNames(q *priorityQueue) []string {
+	var names []string
+	for s, ok := q.pop(); ok; s, ok = q.pop() {
+		if _, ok := s.(forceFlushSpan); ok {
+			names = append(names, "flush")
+			continue
+		}
+		names = append(names, s.Name())
+	}
+	return names
+}
+
+func TestPriorityQueue(t *testing.T) {
+	priority := func(s ReadOnlySpan) SpanPriority {
+		return SpanPriority(s.Name()[0] - '0')
+	}
+	q := newPriorityQueue(3, priority)
+
+	for _, name := range []string{"0a", "1a", "0b"} {
+		dropped, _ := q.push(&snapshot{name: name})
+		require.False(t, dropped)
+	}
+	assert.Equal(t, 3, q.len())
+
+	dropped, p := q.push(&snapshot{name: "2a"})
+	assert.True(t, dropped)
+	assert.Equal(t, SpanPriority(0), p, "oldest lowest priority span should be dropped")
+
+	dropped, p = q.push(&snapshot{name: "0c"})
+	assert.True(t, dropped)
+	assert.Equal(t, SpanPriority(0), p, "oldest span of the same lowest priority should be dropped")
+
+	dropped, p = q.push(&snapshot{name: "1b"})
+	assert.True(t, dropped)
+	assert.Equal(t, SpanPriority(0), p)
+
+	dropped, p = q.push(&snapshot{name: "1c"})
+	assert.True(t, dropped)
+	assert.Equal(t, SpanPriority(1), p, "oldest span of the same lowest priority should be dropped")
+
+	q.pushFlush(forceFlushSpan{flushed: make(chan struct{})})
+	assert.Equal(t, 3, q.len(), "flush requests should not count as spans")
+
+	assert.Equal(t, map[SpanPriority]uint64{0: 3, 1: 1}, q.droppedByPriority())
+	assert.Equal(t, []string{"2a", "1b", "1c", "flush"}, popNames(q), "spans should be popped in push order")
+}
+
+func TestPriorityQueueDropsLowerPrioritySpan(t *testing.T) {
+	priority := func(s ReadOnlySpan) SpanPriority {
+		return SpanPriority(s.Name()[0] - '0')
+	}
+	q := newPriorityQueue(2, priority)
+	for _, name := range []string{"1a", "1b"} {
+		dropped, _ := q.push(&snapshot{name: name})
+		require.False(t, dropped)
+	}
+
+	dropped, p := q.push(&snapshot{name: "0a"})
+	assert.True(t, dropped)
+	assert.Equal(t, SpanPriority(0), p, "span with a lower priority than all queued spans should be dropped")
+	assert.Equal(t, []string{"1a", "1b"}, popNames(q))
+	assert.Equal(t, 0, q.len())
+}
+
+func TestPriorityQueueConcurrentSafe(t *testing.T) {
+	q := newPriorityQueue(10, nil)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s := &snapshot{}
+				if (i+j)%3 == 0 {
+					s.status.Code = codes.Error
+				}
+				q.push(s)
+			}
+		}(i)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for j := 0; j < 100; j++ {
+			q.pop()
+			_ = q.len()
+		}
+	}()
+	wg.Wait()
+	assert.LessOrEqual(t, q.len(), 10)
+}
+
+// gatedExporter blocks the first export until released.
+type gatedExporter struct {
+	*testExporter
+
+	once     sync.Once
+	started  chan struct{}
+	released chan struct{}
+}
+
+func (e *gatedExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	e.once.Do(func() {
+		close(e.started)
+		<-e.released
+	})
+	return e.testExporter.ExportSpans(ctx, spans)
+}
+
+func TestBatchSpanProcessorPriorityQueue(t *testing.T) {
+	mp := newRecordingMeterProvider()
+	exp := &gatedExporter{
+		testExporter: NewTestExporter(),
+		started:      make(chan struct{}),
+		released:     make(chan struct{}),
+	}
+	bsp := NewBatchSpanProcessor(exp,
+		WithMaxQueueSize(2),
+		WithMaxExportBatchSize(1),
+		WithPriorityQueue(nil),
+		WithBatchSpanProcessorMeterProvider(mp),
+	).(*batchSpanProcessor)
+	tp := NewTracerProvider(WithSpanProcessor(bsp))
+	tr := tp.Tracer("TestBatchSpanProcessorPriorityQueue")
+
+	end := func(name string, opts ...trace.SpanStartOption) {
+		_, s := tr.Start(context.Background(), name, opts...)
+		if name == "error" {
+			s.SetStatus(codes.Error, "failed")
+		}
+		s.End()
+	}
+
+	end("first")
+	<-exp.started
+	end("low1")
+	end("low2")
+	end("error")
+	end("server", trace.WithSpanKind(trace.SpanKindServer))
+	end("low3")
+	close(exp.released)
+	require.NoError(t, tp.ForceFlush(context.Background()))
+
+	var names []string
+	for _, s := range exp.Spans() {
+		names = append(names, s.Name())
+	}
+	assert.Equal(t, []string{"first", "error", "server"}, names)
+
+	queueFull := append(bsp.metrics.attrs.ToSlice(), semconv.ErrorTypeKey.String(errorTypeQueueFull))
+	assert.Equal(t, int64(3), mp.count(append(queueFull, attribute.String("otel.sdk.span.priority", "low"))...))
+	assert.Equal(t, int64(0), mp.count(append(queueFull, attribute.String("otel.sdk.span.priority", "high"))...))
+	assert.Equal(t, int64(3), mp.count(bsp.metrics.attrs.ToSlice()...))
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
@@ -42,6 +42,12 @@ type tracerProviderConfig struct {
 
 	// resource contains attributes representing an entity that produces telemetry.
 	resource *resource.Resource
+
+	// tracerConfigurator configures the Tracers per instrumentation scope.
+	tracerConfigurator TracerConfigurator
+
+	// profilerLabels sets pprof labels for the recording spans.
+	profilerLabels bool
 }
 
 // MarshalLog is the marshaling function used by the logging system to represent this Provider.
@@ -72,12 +78,19 @@ type TracerProvider struct {
 
 	isShutdown atomic.Bool
 
+	// tracerConfigurator is protected by the lock mu.
+	tracerConfigurator TracerConfigurator
+
+	// These fields can be updated with SetSampler and SetSpanLimits. The
+	// stored values are immutable.
+	sampler    atomic.Pointer[Sampler]
+	spanLimits atomic.Pointer[SpanLimits]
+
 	// These fields are not protected by the lock mu. They are assumed to be
 	// immutable after creation of the TracerProvider.
-	sampler     Sampler
-	idGenerator IDGenerator
-	spanLimits  SpanLimits
-	resource    *resource.Resource
+	idGenerator    IDGenerator
+	resource       *resource.Resource
+	profilerLabels bool
 }
 
 var _ trace.TracerProvider = &TracerProvider{}
@@ -96,21 +109,23 @@ func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 	o := tracerProviderConfig{
 		spanLimits: NewSpanLimits(),
 	}
-	o = applyTracerProviderEnvConfigs(o)
-
 	for _, opt := range opts {
 		o = opt.apply(o)
 	}
 
+	o = applyTracerProviderEnvConfigs(o)
 	o = ensureValidTracerProviderConfig(o)
 
 	tp := &TracerProvider{
-		namedTracer: make(map[instrumentation.Scope]*tracer),
-		sampler:     o.sampler,
-		idGenerator: o.idGenerator,
-		spanLimits:  o.spanLimits,
-		resource:    o.resource,
+		namedTracer:    make(map[instrumentation.Scope]*tracer),
+		idGenerator:    o.idGenerator,
+		resource:       o.resource,
+		profilerLabels: o.profilerLabels,
+
+		tracerConfigurator: o.tracerConfigurator,
 	}
+	tp.sampler.Store(&o.sampler)
+	tp.spanLimits.Store(&o.spanLimits)
 	global.Info("TracerProvider created", "config", o)
 
 	spss := make(spanProcessorStates, 0, len(o.processors))
@@ -158,6 +173,7 @@ func (p *TracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.T
 				provider:             p,
 				instrumentationScope: is,
 			}
+			p.configureTracer(t)
 			p.namedTracer[is] = t
 		}
 		return t, ok
@@ -261,6 +277,8 @@ func (p *TracerProvider) ForceFlush(ctx context.Context) error {
 
 // Shutdown shuts down TracerProvider. All registered span processors are shut down
 // in the order they were registered and any held computational resources are released.
+// The Sampler of the TracerProvider is also shut down if it, or a delegate of a
+// ParentBased Sampler, has a Shutdown(context.Context) error method.
 // After Shutdown is called, all methods are no-ops.
 func (p *TracerProvider) Shutdown(ctx context.Context) error {
 	// This check prevents deadlocks in case of recursive shutdown.
@@ -296,13 +314,112 @@ func (p *TracerProvider) Shutdown(ctx context.Context) error {
 		}
 	}
 	p.spanProcessors.Store(&spanProcessorStates{})
+
+	if err := shutdownSampler(ctx, *p.sampler.Load()); err != nil {
+		if retErr == nil {
+			retErr = err
+		} else {
+			// Poor man's list of errors
+			retErr = fmt.Errorf("%v; %v", retErr, err)
+		}
+	}
 	return retErr
 }
 
+// samplerShutdowner is implemented by Samplers that hold resources, like the
+// polling goroutine of a JaegerRemoteSampler, that need to be released.
+type samplerShutdowner interface {
+	Shutdown(context.Context) error
+}
+
+// shutdownSampler shuts down s, or the delegates of s if it is a ParentBased
+// Sampler, if they implement samplerShutdowner.
+func shutdownSampler(ctx context.Context, s Sampler) error {
+	switch v := s.(type) {
+	case samplerShutdowner:
+		return v.Shutdown(ctx)
+	case parentBased:
+		var retErr error
+		for _, d := range []Sampler{
+			v.root,
+			v.config.remoteParentSampled,
+			v.config.remoteParentNotSampled,
+			v.config.localParentSampled,
+			v.config.localParentNotSampled,
+		} {
+			if err := shutdownSampler(ctx, d); err != nil {
+				if retErr == nil {
+					retErr = err
+				} else {
+					retErr = fmt.Errorf("%v; %v", retErr, err)
+				}
+			}
+		}
+		return retErr
+	}
+	return nil
+}
+
 func (p *TracerProvider) getSpanProcessors() spanProcessorStates {
 	return *(p.spanProcessors.Load())
 }
 
+// SetSampler replaces the Sampler used by all Tracers of p, including the
+// Tracers already returned, to make the sampling decisions of the Spans they
+// start. Spans already started keep the sampling decision they were started
+// with. Tracers configured with their own Sampler by a TracerConfigurator
+// keep using it.
+//
+// If s is nil, the Sampler is not changed.
+//
+// This method is safe to be called concurrently.
+func (p *TracerProvider) SetSampler(s Sampler) {
+	if s == nil {
+		return
+	}
+	p.sampler.Store(&s)
+	global.Info("TracerProvider sampler updated", "SamplerType", fmt.Sprintf("%T", s))
+}
+
+// SetSpanLimits replaces the SpanLimits bounding the Spans of all Tracers of
+// p, including the Tracers already returned. Spans already started use the
+// new limits for the attributes, events, and links added after this call.
+// Tracers configured with their own SpanLimits by a TracerConfigurator keep
+// using them.
+//
+// The limits are used as-is, see WithRawSpanLimits.
+//
+// This method is safe to be called concurrently.
+func (p *TracerProvider) SetSpanLimits(limits SpanLimits) {
+	p.spanLimits.Store(&limits)
+	global.Info("TracerProvider span limits updated", "SpanLimits", limits)
+}
+
+func (p *TracerProvider) getSampler() Sampler {
+	return *(p.sampler.Load())
+}
+
+func (p *TracerProvider) getSpanLimits() *SpanLimits {
+	return p.spanLimits.Load()
+}
+
+// MarshalLog is the marshaling function used by the logging system to
+// represent the current configuration of this Provider.
+func (p *TracerProvider) MarshalLog() interface{} {
+	spss := p.getSpanProcessors()
+	processors := make([]SpanProcessor, len(spss))
+	for i, sps := range spss {
+		processors[i] = sps.sp
+	}
+	return tracerProviderConfig{
+		processors:  processors,
+		sampler:     p.getSampler(),
+		idGenerator: p.idGenerator,
+		spanLimits:  *p.getSpanLimits(),
+		resource:    p.resource,
+	}.MarshalLog()
+}
+
 // TracerProviderOption configures a TracerProvider.
 type TracerProviderOption interface {
 	apply(tracerProviderConfig) tracerProviderConfig
@@ -374,6 +491,13 @@ func WithIDGenerator(g IDGenerator) TracerProviderOption {
 	})
 }
 
+// WithXRayIDGenerator returns a TracerProviderOption that configures the
+// TracerProvider to generate AWS X-Ray compatible trace IDs using the
+// IDGenerator returned by NewXRayIDGenerator with opts.
+func WithXRayIDGenerator(opts ...XRayIDGeneratorOption) TracerProviderOption {
+	return WithIDGenerator(NewXRayIDGenerator(opts...))
+}
+
 // WithSampler returns a TracerProviderOption that will configure the Sampler
 // s as a TracerProvider's Sampler. The configured Sampler is used by the
 // Tracers the TracerProvider creates to make their sampling decisions for the
@@ -455,27 +579,50 @@ func WithRawSpanLimits(limits SpanLimits) TracerProviderOption {
 	})
 }
 
-func applyTracerProviderEnvConfigs(cfg tracerProviderConfig) tracerProviderConfig {
-	for _, opt := range tracerProviderOptionsFromEnv() {
-		cfg = opt.apply(cfg)
-	}
-
-	return cfg
+// WithProfilerLabels returns a TracerProviderOption that configures a
+// TracerProvider to set pprof labels on the context returned by Tracer.Start
+// for each recording Span, so profiles can be linked to traces. The labels
+// are also set on the goroutine calling Tracer.Start, and the labels of the
+// context passed to Tracer.Start are set back on the goroutine calling
+// Span.End. The goroutine labels are therefore only accurate if each Span is
+// ended on the goroutine that started it, after the Spans it started on that
+// goroutine are ended. For other uses, pass the returned context to
+// pprof.SetGoroutineLabels or pprof.Do instead.
+//
+// The following labels are set:
+//   - span_id: the hex encoded ID of the Span.
+//   - local_root_span_id: the hex encoded ID of the first Span of the trace
+//     started in this process by the Span and its local ancestors.
+//   - span_name: the name of the Span when it is started.
+//
+// Setting the labels adds allocations to each started Span. See
+// BenchmarkStartEndSpanProfilerLabels for the overhead.
+func WithProfilerLabels() TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.profilerLabels = true
+		return cfg
+	})
 }
 
-func tracerProviderOptionsFromEnv() []TracerProviderOption {
-	var opts []TracerProviderOption
-
-	sampler, err := samplerFromEnv()
+// applyTracerProviderEnvConfigs configures cfg with the Sampler defined by
+// the environment if no Sampler was configured with WithSampler. Invalid
+// environment values are reported in either case. It is applied after all
+// options so a Sampler that starts background work, like a
+// JaegerRemoteSampler, is only created if it is used.
+func applyTracerProviderEnvConfigs(cfg tracerProviderConfig) tracerProviderConfig {
+	res := cfg.resource
+	if res == nil {
+		res = resource.Default()
+	}
+	useEnv := cfg.sampler == nil
+	sampler, err := samplerFromEnv(res, useEnv)
 	if err != nil {
 		otel.Handle(err)
 	}
-
-	if sampler != nil {
-		opts = append(opts, WithSampler(sampler))
+	if useEnv && sampler != nil {
+		cfg.sampler = sampler
 	}
-
-	return opts
+	return cfg
 }
 
 // ensureValidTracerProviderConfig ensures that given TracerProviderConfig is valid.
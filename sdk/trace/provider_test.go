@@ -8,12 +8,15 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
 	ottest "go.opentelemetry.io/otel/sdk/internal/internaltest"
+	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -306,6 +309,45 @@ func TestTracerProviderSamplerConfigFromEnv(t *testing.T) {
 			description:         ParentBased(TraceIDRatioBased(1.0)).Description(),
 			invalidArgErrorType: new(samplerArgParseError),
 		},
+		{
+			sampler:     "ratelimited",
+			samplerArg:  "10",
+			description: RateLimited(10, 0).Description(),
+		},
+		{
+			sampler:     "ratelimited",
+			samplerArg:  "10,20",
+			description: RateLimited(10, 20).Description(),
+		},
+		{
+			sampler:     "ratelimited",
+			samplerArg:  "-10",
+			description: RateLimited(1, 0).Description(),
+			errorType:   errNegativeRateLimit,
+		},
+		{
+			sampler:     "ratelimited",
+			samplerArg:  "10,-1",
+			description: RateLimited(10, 0).Description(),
+			errorType:   errNegativeBurst,
+		},
+		{
+			sampler:             "ratelimited",
+			argOptional:         true,
+			description:         RateLimited(1, 0).Description(),
+			invalidArgErrorType: new(samplerArgParseError),
+		},
+		{
+			sampler:     "parentbased_ratelimited",
+			samplerArg:  "10",
+			description: ParentBased(RateLimited(10, 0)).Description(),
+		},
+		{
+			sampler:             "parentbased_ratelimited",
+			argOptional:         true,
+			description:         ParentBased(RateLimited(1, 0)).Description(),
+			invalidArgErrorType: new(samplerArgParseError),
+		},
 	}
 
 	handler.Reset()
@@ -327,7 +369,7 @@ func TestTracerProviderSamplerConfigFromEnv(t *testing.T) {
 			})
 
 			stp := NewTracerProvider(WithSyncer(NewTestExporter()))
-			assert.Equal(t, test.description, stp.sampler.Description())
+			assert.Equal(t, test.description, stp.getSampler().Description())
 			if test.errorType != nil {
 				testStoredError(t, test.errorType)
 			} else {
@@ -350,7 +392,7 @@ func TestTracerProviderSamplerConfigFromEnv(t *testing.T) {
 					t.Cleanup(func() {
 						require.NoError(t, stp.Shutdown(context.Background()))
 					})
-					assert.Equal(t, test.description, stp.sampler.Description())
+					assert.Equal(t, test.description, stp.getSampler().Description())
 
 					if test.invalidArgErrorType != nil {
 						testStoredError(t, test.invalidArgErrorType)
@@ -363,6 +405,131 @@ func TestTracerProviderSamplerConfigFromEnv(t *testing.T) {
 	}
 }
 
+func TestTracerProviderSamplerEnvErrorWithSampler(t *testing.T) {
+	for _, env := range []map[string]string{
+		{"OTEL_TRACES_SAMPLER": "invalid-sampler"},
+		{"OTEL_TRACES_SAMPLER": "traceidratio", "OTEL_TRACES_SAMPLER_ARG": "invalid"},
+		{"OTEL_TRACES_SAMPLER": "jaeger_remote", "OTEL_TRACES_SAMPLER_ARG": "pollingIntervalMs=invalid"},
+	} {
+		t.Run(env["OTEL_TRACES_SAMPLER"], func(t *testing.T) {
+			envStore, err := ottest.SetEnvVariables(env)
+			require.NoError(t, err)
+			handler.Reset()
+			t.Cleanup(func() {
+				handler.Reset()
+				require.NoError(t, envStore.Restore())
+			})
+
+			tp := NewTracerProvider(WithSampler(NeverSample()))
+			t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+			assert.Equal(t, NeverSample().Description(), tp.getSampler().Description())
+			assert.Len(t, handler.errs, 1, "invalid environment should be reported")
+		})
+	}
+}
+
+func TestTracerProviderSetSampler(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithSampler(AlwaysSample()))
+	tr := tp.Tracer("existing")
+
+	tp.SetSampler(NeverSample())
+	_, span := tr.Start(context.Background(), "existing")
+	assert.False(t, span.IsRecording(), "existing tracer should use the new sampler")
+	span.End()
+	_, span = tp.Tracer("new").Start(context.Background(), "new")
+	assert.False(t, span.IsRecording(), "new tracer should use the new sampler")
+	span.End()
+
+	tp.SetSampler(nil)
+	assert.Equal(t, NeverSample(), tp.getSampler(), "nil sampler should be ignored")
+
+	tp.SetSampler(AlwaysSample())
+	_, span = tr.Start(context.Background(), "sampled")
+	assert.True(t, span.IsRecording())
+	span.End()
+
+	require.Equal(t, 1, te.Len())
+	assert.Equal(t, "sampled", te.Spans()[0].Name())
+}
+
+func TestTracerProviderSetSpanLimits(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+	tr := tp.Tracer("existing")
+
+	limits := NewSpanLimits()
+	limits.AttributeCountLimit = 1
+	tp.SetSpanLimits(limits)
+	// Changing the limits after they are set has no effect.
+	limits.AttributeCountLimit = 0
+
+	_, span := tr.Start(context.Background(), "limited")
+	span.SetAttributes(attribute.Int("a", 1), attribute.Int("b", 2))
+	span.End()
+
+	require.Equal(t, 1, te.Len())
+	assert.Len(t, te.Spans()[0].Attributes(), 1)
+	assert.Equal(t, 1, te.Spans()[0].DroppedAttributes())
+}
+
+func TestTracerProviderMarshalLog(t *testing.T) {
+	tp := NewTracerProvider(WithSampler(AlwaysSample()))
+	limits := NewSpanLimits()
+	limits.EventCountLimit = 3
+	tp.SetSampler(NeverSample())
+	tp.SetSpanLimits(limits)
+
+	got, ok := tp.MarshalLog().(struct {
+		SpanProcessors  []SpanProcessor
+		SamplerType     string
+		IDGeneratorType string
+		SpanLimits      SpanLimits
+		Resource        *resource.Resource
+	})
+	require.True(t, ok)
+	assert.Equal(t, fmt.Sprintf("%T", NeverSample()), got.SamplerType)
+	assert.Equal(t, limits, got.SpanLimits)
+}
+
+func TestTracerProviderSetConcurrentSafe(t *testing.T) {
+	tp := NewTracerProvider(WithSyncer(NewTestExporter()))
+	tr := tp.Tracer("concurrent")
+
+	const n = 100
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			if i%2 == 0 {
+				tp.SetSampler(NeverSample())
+			} else {
+				tp.SetSampler(AlwaysSample())
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			limits := NewSpanLimits()
+			limits.AttributeCountLimit = i % 4
+			tp.SetSpanLimits(limits)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			_, span := tr.Start(context.Background(), "span")
+			span.SetAttributes(attribute.Int("a", i), attribute.Int("b", i))
+			span.AddEvent("event")
+			span.End()
+			_ = tp.MarshalLog()
+		}
+	}()
+	wg.Wait()
+}
+
 func testStoredError(t *testing.T, target interface{}) {
 	t.Helper()
 
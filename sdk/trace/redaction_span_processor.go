@@ -0,0 +1,408 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/sdk"
+)
+
+// DefaultRedactionMask is the default value of RedactionConfig.Mask.
+const DefaultRedactionMask = "[REDACTED]"
+
+// Regular expressions matching common sensitive values, to be used as the
+// Pattern of a ValueRedaction.
+const (
+	// EmailPattern matches email addresses.
+	EmailPattern = `[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`
+	// BearerTokenPattern matches bearer authorization credentials.
+	BearerTokenPattern = `(?i)bearer\s+[A-Za-z0-9\-._~+/]+=*`
+	// CreditCardPattern matches sequences of 13 to 19 digits, optionally
+	// separated by spaces or dashes, like credit card numbers.
+	CreditCardPattern = `\b(?:\d[ -]?){12,18}\d\b`
+)
+
+// redactionHashPrefix prefixes hashed values. It is used to recognize values
+// already hashed.
+const redactionHashPrefix = "sha256:"
+
+var errNoRedactionPattern = errors.New("value redaction has no pattern")
+
+// RedactionAction is the action taken on a redacted value.
+type RedactionAction uint8
+
+const (
+	// RedactMask replaces the redacted value with the mask.
+	RedactMask RedactionAction = iota
+	// RedactHash replaces the redacted value with its SHA-256 hash. Hashing
+	// keeps values correlatable without disclosing them, but low entropy
+	// values can be recovered from their hash.
+	RedactHash
+	// RedactDrop removes the redacted attribute. It is only supported for
+	// attributes redacted by key, see RedactionConfig.KeyAction.
+	RedactDrop
+)
+
+// ValueRedaction redacts the parts of string values matching a pattern.
+type ValueRedaction struct {
+	// Pattern is the regular expression matched against string values. It
+	// is required. The pattern should not match the mask or a hashed value.
+	Pattern string
+	// Action is the action taken on the matched parts of the value. Only
+	// RedactMask and RedactHash are supported, RedactDrop is handled as
+	// RedactMask.
+	Action RedactionAction
+}
+
+// RedactionConfig is the configuration of a RedactionProcessor.
+type RedactionConfig struct {
+	// AllowedKeys, if not empty, are the only attribute keys whose values
+	// are not redacted by key.
+	AllowedKeys []attribute.Key
+	// DeniedKeys are the attribute keys whose values are redacted.
+	DeniedKeys []attribute.Key
+	// KeyAction is the action taken on the attributes redacted by key.
+	KeyAction RedactionAction
+
+	// Values are applied to the string and string slice attribute values
+	// not redacted by key, and to the status description.
+	Values []ValueRedaction
+
+	// Mask replaces masked values. If empty, DefaultRedactionMask is used.
+	Mask string
+
+	// MeterProvider is used to report the number of redacted fields. If nil,
+	// no metrics are reported.
+	MeterProvider metric.MeterProvider
+}
+
+type valueRedaction struct {
+	re     *regexp.Regexp
+	action RedactionAction
+}
+
+// RedactionProcessor is a SpanProcessor that redacts sensitive data from the
+// span attributes, event attributes, link attributes, and status description
+// of spans before passing them to the next SpanProcessor.
+//
+// Attributes a span is started with are redacted in place when it starts,
+// so later SpanProcessors never observe their original value. All the data
+// of the span is redacted again when it ends, the redacted span is passed to
+// the next SpanProcessor. Because started spans cannot have attributes
+// removed, attributes redacted with RedactDrop are masked until the span
+// ends.
+type RedactionProcessor struct {
+	next SpanProcessor
+
+	allowed   map[attribute.Key]struct{}
+	denied    map[attribute.Key]struct{}
+	keyAction RedactionAction
+	values    []valueRedaction
+	mask      string
+
+	redacted      atomic.Uint64
+	redactedCount metric.Int64Counter
+}
+
+var _ SpanProcessor = (*RedactionProcessor)(nil)
+
+// NewRedactionProcessor returns a RedactionProcessor that redacts spans
+// according to cfg and passes them to next.
+//
+// An error is returned if a ValueRedaction of cfg has no or an invalid
+// pattern.
+func NewRedactionProcessor(next SpanProcessor, cfg RedactionConfig) (*RedactionProcessor, error) {
+	rp := &RedactionProcessor{
+		next:      next,
+		keyAction: cfg.KeyAction,
+		mask:      cfg.Mask,
+	}
+	if rp.mask == "" {
+		rp.mask = DefaultRedactionMask
+	}
+	if len(cfg.AllowedKeys) > 0 {
+		rp.allowed = make(map[attribute.Key]struct{}, len(cfg.AllowedKeys))
+		for _, k := range cfg.AllowedKeys {
+			rp.allowed[k] = struct{}{}
+		}
+	}
+	rp.denied = make(map[attribute.Key]struct{}, len(cfg.DeniedKeys))
+	for _, k := range cfg.DeniedKeys {
+		rp.denied[k] = struct{}{}
+	}
+
+	var errs []error
+	for i, v := range cfg.Values {
+		if v.Pattern == "" {
+			errs = append(errs, fmt.Errorf("value redaction %d: %w", i, errNoRedactionPattern))
+			continue
+		}
+		re, err := regexp.Compile(v.Pattern)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("value redaction %d: %w", i, err))
+			continue
+		}
+		rp.values = append(rp.values, valueRedaction{re: re, action: v.Action})
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	mp := cfg.MeterProvider
+	if mp == nil {
+		mp = noop.NewMeterProvider()
+	}
+	var err error
+	rp.redactedCount, err = mp.Meter(
+		selfObservabilityScope,
+		metric.WithInstrumentationVersion(sdk.Version()),
+	).Int64Counter(
+		"otel.sdk.processor.span.redacted",
+		metric.WithUnit("{field}"),
+		metric.WithDescription("The number of span fields redacted by the span processor."),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	if rp.redactedCount == nil {
+		rp.redactedCount = noop.Int64Counter{}
+	}
+	return rp, nil
+}
+
+// OnStart redacts the attributes s is started with in place and passes s to
+// the next SpanProcessor.
+func (rp *RedactionProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	var changed []attribute.KeyValue
+	var n int
+	for _, kv := range s.Attributes() {
+		r, keep, ok := rp.redactAttribute(kv)
+		if !ok {
+			continue
+		}
+		if keep {
+			n++
+		} else {
+			// Attributes cannot be removed from a started span. They are
+			// masked here and counted when dropped as the span ends.
+			r = attribute.String(string(kv.Key), rp.mask)
+		}
+		changed = append(changed, r)
+	}
+	if len(changed) > 0 {
+		s.SetAttributes(changed...)
+	}
+	rp.count(n)
+	rp.next.OnStart(parent, s)
+}
+
+// OnEnd passes the redacted s to the next SpanProcessor.
+func (rp *RedactionProcessor) OnEnd(s ReadOnlySpan) {
+	rs := redactedSpan{ReadOnlySpan: s, status: s.Status()}
+	var n int
+
+	var c int
+	rs.attrs, c = rp.redactAttributes(s.Attributes())
+	n += c
+
+	if events := s.Events(); len(events) > 0 {
+		rs.events = make([]Event, len(events))
+		for i, e := range events {
+			e.Attributes, c = rp.redactAttributes(e.Attributes)
+			n += c
+			rs.events[i] = e
+		}
+	}
+	if links := s.Links(); len(links) > 0 {
+		rs.links = make([]Link, len(links))
+		for i, l := range links {
+			l.Attributes, c = rp.redactAttributes(l.Attributes)
+			n += c
+			rs.links[i] = l
+		}
+	}
+	if desc, ok := rp.redactString(rs.status.Description); ok {
+		rs.status.Description = desc
+		n++
+	}
+
+	if n == 0 {
+		rp.next.OnEnd(s)
+		return
+	}
+	rp.count(n)
+	rp.next.OnEnd(rs)
+}
+
+func (rp *RedactionProcessor) count(n int) {
+	if n == 0 {
+		return
+	}
+	rp.redacted.Add(uint64(n))
+	rp.redactedCount.Add(context.Background(), int64(n))
+}
+
+// RedactedFields returns the number of span fields redacted.
+func (rp *RedactionProcessor) RedactedFields() uint64 {
+	return rp.redacted.Load()
+}
+
+// Shutdown shuts down the next SpanProcessor.
+func (rp *RedactionProcessor) Shutdown(ctx context.Context) error {
+	return rp.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the next SpanProcessor.
+func (rp *RedactionProcessor) ForceFlush(ctx context.Context) error {
+	return rp.next.ForceFlush(ctx)
+}
+
+// redactAttributes returns attrs redacted and the number of redacted
+// attributes. attrs is not modified.
+func (rp *RedactionProcessor) redactAttributes(attrs []attribute.KeyValue) ([]attribute.KeyValue, int) {
+	var (
+		out []attribute.KeyValue
+		n   int
+	)
+	for i, kv := range attrs {
+		r, keep, ok := rp.redactAttribute(kv)
+		if ok && out == nil {
+			// Copy on first change.
+			out = make([]attribute.KeyValue, i, len(attrs))
+			copy(out, attrs[:i])
+		}
+		if ok {
+			n++
+		}
+		if out != nil && keep {
+			out = append(out, r)
+		}
+	}
+	if out == nil {
+		return attrs, 0
+	}
+	return out, n
+}
+
+// redactAttribute returns kv redacted and if the redacted attribute is kept.
+// The returned bool ok is false if kv does not need to be redacted.
+func (rp *RedactionProcessor) redactAttribute(kv attribute.KeyValue) (r attribute.KeyValue, keep, ok bool) {
+	if rp.deniedKey(kv.Key) {
+		switch rp.keyAction {
+		case RedactDrop:
+			return kv, false, true
+		case RedactHash:
+			if kv.Value.Type() == attribute.STRING && isRedactionHash(kv.Value.AsString()) {
+				return kv, true, false
+			}
+			return attribute.String(string(kv.Key), redactionHash(kv.Value.Emit())), true, true
+		default:
+			if kv.Value.Type() == attribute.STRING && kv.Value.AsString() == rp.mask {
+				return kv, true, false
+			}
+			return attribute.String(string(kv.Key), rp.mask), true, true
+		}
+	}
+
+	switch kv.Value.Type() {
+	case attribute.STRING:
+		if s, changed := rp.redactString(kv.Value.AsString()); changed {
+			return attribute.String(string(kv.Key), s), true, true
+		}
+	case attribute.STRINGSLICE:
+		v := kv.Value.AsStringSlice()
+		var changed bool
+		for i, s := range v {
+			if r, c := rp.redactString(s); c {
+				v[i] = r
+				changed = true
+			}
+		}
+		if changed {
+			return attribute.StringSlice(string(kv.Key), v), true, true
+		}
+	}
+	return kv, true, false
+}
+
+func (rp *RedactionProcessor) deniedKey(k attribute.Key) bool {
+	if _, ok := rp.denied[k]; ok {
+		return true
+	}
+	if rp.allowed != nil {
+		_, ok := rp.allowed[k]
+		return !ok
+	}
+	return false
+}
+
+// redactString returns s with all value redactions applied and if s was
+// changed.
+func (rp *RedactionProcessor) redactString(s string) (string, bool) {
+	if s == "" {
+		return s, false
+	}
+	orig := s
+	for _, v := range rp.values {
+		if v.action == RedactHash {
+			s = v.re.ReplaceAllStringFunc(s, func(m string) string {
+				if isRedactionHash(m) {
+					return m
+				}
+				return redactionHash(m)
+			})
+			continue
+		}
+		s = v.re.ReplaceAllLiteralString(s, rp.mask)
+	}
+	return s, s != orig
+}
+
+func redactionHash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return redactionHashPrefix + hex.EncodeToString(sum[:])
+}
+
+func isRedactionHash(s string) bool {
+	h, ok := strings.CutPrefix(s, redactionHashPrefix)
+	if !ok || len(h) != 2*sha256.Size {
+		return false
+	}
+	_, err := hex.DecodeString(h)
+	return err == nil
+}
+
+// redactedSpan is a ReadOnlySpan with redacted data.
+type redactedSpan struct {
+	ReadOnlySpan
+
+	attrs  []attribute.KeyValue
+	events []Event
+	links  []Link
+	status Status
+}
+
+// Attributes returns the redacted attributes of the span.
+func (s redactedSpan) Attributes() []attribute.KeyValue { return s.attrs }
+
+// Events returns the events of the span with redacted attributes.
+func (s redactedSpan) Events() []Event { return s.events }
+
+// Links returns the links of the span with redacted attributes.
+func (s redactedSpan) Links() []Link { return s.links }
+
+// Status returns the status of the span with a redacted description.
+func (s redactedSpan) Status() Status { return s.status }
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newRedactionTracer(t *testing.T, cfg sdktrace.RedactionConfig, processors ...sdktrace.SpanProcessor) (*sdktrace.RedactionProcessor, *tracetest.InMemoryExporter, trace.Tracer) {
+	exp := tracetest.NewInMemoryExporter()
+	rp, err := sdktrace.NewRedactionProcessor(sdktrace.NewSimpleSpanProcessor(exp), cfg)
+	require.NoError(t, err)
+	opts := []sdktrace.TracerProviderOption{sdktrace.WithSpanProcessor(rp)}
+	for _, p := range processors {
+		opts = append(opts, sdktrace.WithSpanProcessor(p))
+	}
+	tp := sdktrace.NewTracerProvider(opts...)
+	return rp, exp, tp.Tracer("TestRedactionProcessor")
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func TestRedactionProcessorKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  sdktrace.RedactionConfig
+		want []attribute.KeyValue
+	}{
+		{
+			name: "deny mask",
+			cfg:  sdktrace.RedactionConfig{DeniedKeys: []attribute.Key{"password", "user.id"}},
+			want: []attribute.KeyValue{
+				attribute.String("http.method", "GET"),
+				attribute.String("password", "[REDACTED]"),
+				attribute.String("user.id", "[REDACTED]"),
+			},
+		},
+		{
+			name: "deny hash",
+			cfg: sdktrace.RedactionConfig{
+				DeniedKeys: []attribute.Key{"password", "user.id"},
+				KeyAction:  sdktrace.RedactHash,
+			},
+			want: []attribute.KeyValue{
+				attribute.String("http.method", "GET"),
+				attribute.String("password", sha256Hex("hunter2")),
+				attribute.String("user.id", sha256Hex("42")),
+			},
+		},
+		{
+			name: "deny drop",
+			cfg: sdktrace.RedactionConfig{
+				DeniedKeys: []attribute.Key{"password", "user.id"},
+				KeyAction:  sdktrace.RedactDrop,
+			},
+			want: []attribute.KeyValue{attribute.String("http.method", "GET")},
+		},
+		{
+			name: "allow list",
+			cfg: sdktrace.RedactionConfig{
+				AllowedKeys: []attribute.Key{"http.method"},
+				Mask:        "***",
+			},
+			want: []attribute.KeyValue{
+				attribute.String("http.method", "GET"),
+				attribute.String("password", "***"),
+				attribute.String("user.id", "***"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rp, exp, tr := newRedactionTracer(t, test.cfg)
+			_, span := tr.Start(context.Background(), "span", trace.WithAttributes(
+				attribute.String("http.method", "GET"),
+				attribute.String("password", "hunter2"),
+			))
+			span.SetAttributes(attribute.Int("user.id", 42))
+			span.End()
+
+			spans := exp.GetSpans()
+			require.Len(t, spans, 1)
+			assert.ElementsMatch(t, test.want, spans[0].Attributes)
+			assert.Equal(t, uint64(2), rp.RedactedFields())
+		})
+	}
+}
+
+func TestRedactionProcessorValues(t *testing.T) {
+	rp, exp, tr := newRedactionTracer(t, sdktrace.RedactionConfig{
+		Values: []sdktrace.ValueRedaction{
+			{Pattern: sdktrace.EmailPattern, Action: sdktrace.RedactHash},
+			{Pattern: sdktrace.BearerTokenPattern},
+			{Pattern: sdktrace.CreditCardPattern},
+		},
+	})
+
+	_, span := tr.Start(context.Background(), "span", trace.WithAttributes(
+		attribute.String("user", "contact jane@example.com"),
+		attribute.StringSlice("headers", []string{"Authorization: Bearer abc.def-ghi", "Accept: */*"}),
+		attribute.Int("count", 4111111111111111),
+	))
+	span.AddEvent("payment", trace.WithAttributes(attribute.String("card", "4111 1111 1111 1111")))
+	span.AddLink(trace.Link{
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID{1}, SpanID: trace.SpanID{1}}),
+		Attributes:  []attribute.KeyValue{attribute.String("owner", "joe@example.org")},
+	})
+	span.SetStatus(codes.Error, "charge failed for card 4111-1111-1111-1111")
+	span.End()
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 1)
+	s := spans[0]
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("user", "contact "+sha256Hex("jane@example.com")),
+		attribute.StringSlice("headers", []string{"Authorization: [REDACTED]", "Accept: */*"}),
+		attribute.Int("count", 4111111111111111),
+	}, s.Attributes)
+	require.Len(t, s.Events, 1)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("card", "[REDACTED]")}, s.Events[0].Attributes)
+	require.Len(t, s.Links, 1)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("owner", sha256Hex("joe@example.org"))}, s.Links[0].Attributes)
+	assert.Equal(t, "charge failed for card [REDACTED]", s.Status.Description)
+	assert.Equal(t, uint64(5), rp.RedactedFields())
+}
+
+func TestRedactionProcessorOnStart(t *testing.T) {
+	// A SpanProcessor registered after the RedactionProcessor only observes
+	// redacted start attributes.
+	observer := &testSpanProcessor{}
+	_, exp, tr := newRedactionTracer(t, sdktrace.RedactionConfig{
+		DeniedKeys: []attribute.Key{"token", "password"},
+		KeyAction:  sdktrace.RedactHash,
+		Values:     []sdktrace.ValueRedaction{{Pattern: sdktrace.EmailPattern, Action: sdktrace.RedactHash}},
+	}, observer)
+
+	_, span := tr.Start(context.Background(), "span", trace.WithAttributes(
+		attribute.String("token", "secret"),
+		attribute.String("email", "jane@example.com"),
+	))
+	require.Len(t, observer.spansStarted, 1)
+	want := []attribute.KeyValue{
+		attribute.String("token", sha256Hex("secret")),
+		attribute.String("email", sha256Hex("jane@example.com")),
+	}
+	assert.Equal(t, want, observer.spansStarted[0].Attributes())
+	span.End()
+
+	// Values redacted at start are not redacted again.
+	spans := exp.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, want, spans[0].Attributes)
+}
+
+func TestRedactionProcessorUnchangedSpan(t *testing.T) {
+	rp, exp, tr := newRedactionTracer(t, sdktrace.RedactionConfig{DeniedKeys: []attribute.Key{"password"}})
+	_, span := tr.Start(context.Background(), "span", trace.WithAttributes(attribute.String("k", "v")))
+	span.End()
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("k", "v")}, spans[0].Attributes)
+	assert.Zero(t, rp.RedactedFields())
+}
+
+func TestRedactionProcessorInvalidConfig(t *testing.T) {
+	_, err := sdktrace.NewRedactionProcessor(&testSpanProcessor{}, sdktrace.RedactionConfig{
+		Values: []sdktrace.ValueRedaction{{}, {Pattern: "("}},
+	})
+	assert.ErrorContains(t, err, "value redaction 0")
+	assert.ErrorContains(t, err, "value redaction 1")
+}
@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SpanRoute routes the ended spans it matches to a SpanProcessor.
+type SpanRoute struct {
+	// Match reports whether a span is routed to Processor.
+	Match SpanFilter
+	// Processor receives the spans matched by Match.
+	Processor SpanProcessor
+}
+
+// RouteByScope returns a SpanRoute that routes spans created by Tracers with
+// the instrumentation scope name to p.
+func RouteByScope(name string, p SpanProcessor) SpanRoute {
+	return SpanRoute{Match: ScopeFilter(name), Processor: p}
+}
+
+// RouteByAttribute returns a SpanRoute that routes spans with the attribute
+// kv to p.
+func RouteByAttribute(kv attribute.KeyValue, p SpanProcessor) SpanRoute {
+	return SpanRoute{
+		Match: func(s ReadOnlySpan) bool {
+			for _, attr := range s.Attributes() {
+				if attr == kv {
+					return true
+				}
+			}
+			return false
+		},
+		Processor: p,
+	}
+}
+
+// RoutingProcessor is a SpanProcessor that passes each ended span to the
+// SpanProcessor of the first SpanRoute matching it.
+type RoutingProcessor struct {
+	routes   []SpanRoute
+	fallback SpanProcessor
+	// processors are the distinct SpanProcessors of routes and fallback.
+	processors []SpanProcessor
+}
+
+var _ SpanProcessor = (*RoutingProcessor)(nil)
+
+// NewRoutingProcessor returns a RoutingProcessor that passes each ended span
+// to the Processor of the first of routes that matches it. Spans matched by
+// no route are passed to fallback, or dropped if fallback is nil.
+//
+// Started spans are passed to the Processor of all routes and fallback, the
+// routes are evaluated once the span has ended and all its data is known. A
+// SpanProcessor used by multiple routes, or as fallback, receives each
+// started span, and is flushed and shut down, only once.
+func NewRoutingProcessor(fallback SpanProcessor, routes ...SpanRoute) *RoutingProcessor {
+	rp := &RoutingProcessor{fallback: fallback}
+	for _, r := range routes {
+		if r.Match == nil || r.Processor == nil {
+			continue
+		}
+		rp.routes = append(rp.routes, r)
+		rp.addProcessor(r.Processor)
+	}
+	if fallback != nil {
+		rp.addProcessor(fallback)
+	}
+	return rp
+}
+
+func (rp *RoutingProcessor) addProcessor(p SpanProcessor) {
+	for _, known := range rp.processors {
+		if sameSpanProcessor(known, p) {
+			return
+		}
+	}
+	rp.processors = append(rp.processors, p)
+}
+
+// sameSpanProcessor reports whether a and b are the same SpanProcessor.
+// SpanProcessors of uncomparable types are never the same.
+func sameSpanProcessor(a, b SpanProcessor) bool {
+	t := reflect.TypeOf(a)
+	if t != reflect.TypeOf(b) || !t.Comparable() {
+		return false
+	}
+	return a == b
+}
+
+// OnStart passes s to all SpanProcessors of rp.
+func (rp *RoutingProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	for _, p := range rp.processors {
+		p.OnStart(parent, s)
+	}
+}
+
+// OnEnd passes s to the SpanProcessor of the first route matching it.
+func (rp *RoutingProcessor) OnEnd(s ReadOnlySpan) {
+	for _, r := range rp.routes {
+		if r.Match(s) {
+			r.Processor.OnEnd(s)
+			return
+		}
+	}
+	if rp.fallback != nil {
+		rp.fallback.OnEnd(s)
+	}
+}
+
+// Shutdown shuts down all SpanProcessors of rp.
+func (rp *RoutingProcessor) Shutdown(ctx context.Context) error {
+	return rp.each(func(p SpanProcessor) error { return p.Shutdown(ctx) })
+}
+
+// ForceFlush flushes all SpanProcessors of rp.
+func (rp *RoutingProcessor) ForceFlush(ctx context.Context) error {
+	return rp.each(func(p SpanProcessor) error { return p.ForceFlush(ctx) })
+}
+
+func (rp *RoutingProcessor) each(f func(SpanProcessor) error) error {
+	var errs []error
+	for _, p := range rp.processors {
+		if err := f(p); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type failingShutdownProcessor struct {
+	testSpanProcessor
+	err error
+}
+
+func (p *failingShutdownProcessor) Shutdown(context.Context) error { return p.err }
+
+func TestRoutingProcessor(t *testing.T) {
+	debug := &testSpanProcessor{name: "debug"}
+	db := &testSpanProcessor{name: "db"}
+	fallback := &testSpanProcessor{name: "fallback"}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(
+		sdktrace.NewRoutingProcessor(fallback,
+			sdktrace.RouteByAttribute(attribute.Bool("debug", true), debug),
+			sdktrace.RouteByScope("db", db),
+			sdktrace.SpanRoute{}, // Ignored.
+		),
+	))
+
+	_, span := tp.Tracer("db").Start(context.Background(), "debug query")
+	// Attributes set after the span started are used to route it.
+	span.SetAttributes(attribute.Bool("debug", true))
+	span.End()
+	_, span = tp.Tracer("db").Start(context.Background(), "query")
+	span.End()
+	_, span = tp.Tracer("http").Start(context.Background(), "request", trace.WithAttributes(attribute.Bool("debug", false)))
+	span.End()
+
+	for _, sp := range []*testSpanProcessor{debug, db, fallback} {
+		assert.Len(t, sp.spansStarted, 3, sp.name)
+	}
+	names := func(sp *testSpanProcessor) []string {
+		var out []string
+		for _, s := range sp.spansEnded {
+			out = append(out, s.Name())
+		}
+		return out
+	}
+	assert.Equal(t, []string{"debug query"}, names(debug))
+	assert.Equal(t, []string{"query"}, names(db))
+	assert.Equal(t, []string{"request"}, names(fallback))
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+	for _, sp := range []*testSpanProcessor{debug, db, fallback} {
+		assert.Equal(t, 1, sp.shutdownCount, sp.name)
+	}
+}
+
+func TestRoutingProcessorNoFallback(t *testing.T) {
+	db := &testSpanProcessor{name: "db"}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(
+		sdktrace.NewRoutingProcessor(nil, sdktrace.RouteByScope("db", db)),
+	))
+
+	_, span := tp.Tracer("http").Start(context.Background(), "request")
+	span.End()
+	assert.Empty(t, db.spansEnded)
+	assert.NoError(t, tp.ForceFlush(context.Background()))
+	assert.NoError(t, tp.Shutdown(context.Background()))
+}
+
+func TestRoutingProcessorShutdownErrors(t *testing.T) {
+	errA, errB := errors.New("a"), errors.New("b")
+	a := &failingShutdownProcessor{err: errA}
+	b := &failingShutdownProcessor{err: errB}
+	rp := sdktrace.NewRoutingProcessor(b, sdktrace.RouteByScope("a", a))
+
+	err := rp.Shutdown(context.Background())
+	assert.ErrorIs(t, err, errA)
+	assert.ErrorIs(t, err, errB)
+}
+
+func TestRoutingProcessorSharedProcessor(t *testing.T) {
+	shared := &testSpanProcessor{name: "shared"}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(
+		sdktrace.NewRoutingProcessor(shared,
+			sdktrace.RouteByScope("a", shared),
+			sdktrace.RouteByScope("b", shared),
+		),
+	))
+
+	_, span := tp.Tracer("a").Start(context.Background(), "span")
+	span.End()
+	assert.Len(t, shared.spansStarted, 1)
+	assert.Len(t, shared.spansEnded, 1)
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+	assert.Equal(t, 1, shared.shutdownCount)
+}
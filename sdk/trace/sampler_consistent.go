@@ -0,0 +1,314 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// otelTraceStateKey is the key of the OpenTelemetry entry in a
+	// trace.TraceState.
+	otelTraceStateKey = "ot"
+
+	// thresholdKey is the sub-key of the OpenTelemetry trace state entry
+	// holding the rejection threshold used to sample a span.
+	thresholdKey = "th"
+	// randomnessKey is the sub-key of the OpenTelemetry trace state entry
+	// holding an explicit randomness value.
+	randomnessKey = "rv"
+
+	// randomnessBits is the number of bits of randomness used to make
+	// consistent sampling decisions.
+	randomnessBits = 56
+	// maxThreshold is the exclusive upper bound of thresholds and randomness
+	// values. A threshold of zero samples every span.
+	maxThreshold uint64 = 1 << randomnessBits
+	// maxHexDigits is the number of hexadecimal digits needed to encode
+	// randomnessBits.
+	maxHexDigits = randomnessBits / 4
+)
+
+var (
+	errInvalidOTelTraceState = errors.New("invalid OpenTelemetry trace state")
+	errInvalidThreshold      = errors.New("invalid sampling threshold")
+	errInvalidRandomness     = errors.New("invalid sampling randomness")
+)
+
+// otelTraceState is the parsed value of the OpenTelemetry entry of a
+// trace.TraceState.
+type otelTraceState struct {
+	threshold     uint64
+	hasThreshold  bool
+	randomness    uint64
+	hasRandomness bool
+	// rest holds all other sub-key and value pairs in their original order.
+	rest []string
+}
+
+// parseOTelTraceState parses the OpenTelemetry entry value v.
+func parseOTelTraceState(v string) (otelTraceState, error) {
+	var ots otelTraceState
+	if v == "" {
+		return ots, nil
+	}
+	for _, member := range strings.Split(v, ";") {
+		key, value, ok := strings.Cut(member, ":")
+		if !ok || key == "" {
+			return otelTraceState{}, fmt.Errorf("%w: %q", errInvalidOTelTraceState, v)
+		}
+		var err error
+		switch key {
+		case thresholdKey:
+			ots.threshold, err = parseThreshold(value)
+			ots.hasThreshold = true
+		case randomnessKey:
+			ots.randomness, err = parseRandomness(value)
+			ots.hasRandomness = true
+		default:
+			ots.rest = append(ots.rest, member)
+		}
+		if err != nil {
+			return otelTraceState{}, err
+		}
+	}
+	return ots, nil
+}
+
+// String returns the encoded OpenTelemetry trace state entry value.
+func (ots otelTraceState) String() string {
+	members := make([]string, 0, len(ots.rest)+2)
+	if ots.hasThreshold {
+		members = append(members, thresholdKey+":"+formatThreshold(ots.threshold))
+	}
+	if ots.hasRandomness {
+		members = append(members, fmt.Sprintf("%s:%0*x", randomnessKey, maxHexDigits, ots.randomness))
+	}
+	members = append(members, ots.rest...)
+	return strings.Join(members, ";")
+}
+
+// parseThreshold parses a threshold encoded as 1 to 14 hexadecimal digits with
+// trailing zeros omitted.
+func parseThreshold(s string) (uint64, error) {
+	if len(s) == 0 || len(s) > maxHexDigits {
+		return 0, fmt.Errorf("%w: %q", errInvalidThreshold, s)
+	}
+	t, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", errInvalidThreshold, s)
+	}
+	return t << (4 * (maxHexDigits - len(s))), nil
+}
+
+// formatThreshold returns the encoding of the threshold t with trailing zeros
+// omitted.
+func formatThreshold(t uint64) string {
+	s := strings.TrimRight(fmt.Sprintf("%0*x", maxHexDigits, t), "0")
+	if s == "" {
+		return "0"
+	}
+	return s
+}
+
+// parseRandomness parses a randomness value encoded as exactly 14 hexadecimal
+// digits.
+func parseRandomness(s string) (uint64, error) {
+	if len(s) != maxHexDigits {
+		return 0, fmt.Errorf("%w: %q", errInvalidRandomness, s)
+	}
+	r, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", errInvalidRandomness, s)
+	}
+	return r, nil
+}
+
+// otelTraceStateFrom returns the parsed OpenTelemetry entry of ts. An invalid
+// entry is logged and an empty otelTraceState is returned in its place.
+func otelTraceStateFrom(ts trace.TraceState) otelTraceState {
+	ots, err := parseOTelTraceState(ts.Get(otelTraceStateKey))
+	if err != nil {
+		global.Warn("ignoring invalid OpenTelemetry trace state", "error", err)
+	}
+	return ots
+}
+
+// withOTelTraceState returns ts with its OpenTelemetry entry set to ots. The
+// entry is removed if ots is empty.
+func withOTelTraceState(ts trace.TraceState, ots otelTraceState) trace.TraceState {
+	v := ots.String()
+	if v == "" {
+		return ts.Delete(otelTraceStateKey)
+	}
+	updated, err := ts.Insert(otelTraceStateKey, v)
+	if err != nil {
+		global.Warn("failed to update OpenTelemetry trace state", "error", err)
+		return ts
+	}
+	return updated
+}
+
+// randomness returns the randomness value used to make a consistent sampling
+// decision. The explicit randomness value of ots is used if present,
+// otherwise the least significant 56 bits of traceID are used.
+func randomness(ots otelTraceState, traceID trace.TraceID) uint64 {
+	if ots.hasRandomness {
+		return ots.randomness
+	}
+	return binary.BigEndian.Uint64(traceID[8:16]) & (maxThreshold - 1)
+}
+
+// thresholdFromProbability returns the rejection threshold that samples with
+// the given probability and whether any span can be sampled at all.
+func thresholdFromProbability(fraction float64) (uint64, bool) {
+	if fraction >= 1 {
+		return 0, true
+	}
+	accepted := uint64(math.Round(fraction * float64(maxThreshold)))
+	if fraction <= 0 || accepted == 0 {
+		return 0, false
+	}
+	return maxThreshold - accepted, true
+}
+
+type consistentProbabilitySampler struct {
+	threshold   uint64
+	sampling    bool
+	description string
+}
+
+func (cs consistentProbabilitySampler) ShouldSample(p SamplingParameters) SamplingResult {
+	ts := trace.SpanContextFromContext(p.ParentContext).TraceState()
+	ots := otelTraceStateFrom(ts)
+
+	if cs.sampling && randomness(ots, p.TraceID) >= cs.threshold {
+		ots.threshold, ots.hasThreshold = cs.threshold, true
+		return SamplingResult{
+			Decision:   RecordAndSample,
+			Tracestate: withOTelTraceState(ts, ots),
+		}
+	}
+
+	// Unsampled spans must not carry a threshold.
+	ots.threshold, ots.hasThreshold = 0, false
+	return SamplingResult{
+		Decision:   Drop,
+		Tracestate: withOTelTraceState(ts, ots),
+	}
+}
+
+func (cs consistentProbabilitySampler) Description() string {
+	return cs.description
+}
+
+// ConsistentProbabilityBased samples a given fraction of traces using the
+// OpenTelemetry consistent probability sampling scheme. Fractions >= 1 will
+// always sample. Fractions <= 0 will never sample.
+//
+// The sampling decision compares a rejection threshold derived from fraction
+// with the randomness of the trace. The randomness is read from the "rv"
+// sub-key of the "ot" trace state entry if present, otherwise it is the least
+// significant 56 bits of the trace ID. The threshold of sampled spans is
+// recorded with the "th" sub-key of the "ot" trace state entry so the
+// adjusted count of the span can be determined with AdjustedCount.
+//
+// To respect the parent trace's `SampledFlag` and threshold, the
+// ConsistentProbabilityBased sampler should be used as a delegate of a
+// ConsistentParentBased sampler.
+func ConsistentProbabilityBased(fraction float64) Sampler {
+	threshold, sampling := thresholdFromProbability(fraction)
+	if fraction > 1 {
+		fraction = 1
+	}
+	if fraction < 0 {
+		fraction = 0
+	}
+	return consistentProbabilitySampler{
+		threshold:   threshold,
+		sampling:    sampling,
+		description: fmt.Sprintf("ConsistentProbabilityBased{%g}", fraction),
+	}
+}
+
+// consistentParentSampler makes the sampling decision of a span based on its
+// parent, keeping the threshold of the parent trace state consistent with the
+// decision.
+type consistentParentSampler struct {
+	sampled bool
+}
+
+func (cs consistentParentSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	ts := trace.SpanContextFromContext(p.ParentContext).TraceState()
+	ots := otelTraceStateFrom(ts)
+
+	if !cs.sampled {
+		if ots.hasThreshold {
+			ots.threshold, ots.hasThreshold = 0, false
+			ts = withOTelTraceState(ts, ots)
+		}
+		return SamplingResult{Decision: Drop, Tracestate: ts}
+	}
+
+	// A threshold that would not have sampled the trace cannot be trusted to
+	// extrapolate span counts and is removed.
+	if ots.hasThreshold && randomness(ots, p.TraceID) < ots.threshold {
+		ots.threshold, ots.hasThreshold = 0, false
+		ts = withOTelTraceState(ts, ots)
+	}
+	return SamplingResult{Decision: RecordAndSample, Tracestate: ts}
+}
+
+func (cs consistentParentSampler) Description() string {
+	if cs.sampled {
+		return "ConsistentParentSampled"
+	}
+	return "ConsistentParentNotSampled"
+}
+
+// ConsistentParentBased returns a sampler decorator which behaves like
+// ParentBased, but by default respects the sampling decision of the parent
+// while keeping the "th" sub-key of the "ot" trace state entry consistent
+// with that decision. If the span has no parent, the root sampler is used to
+// make the sampling decision. The root sampler should be a
+// ConsistentProbabilityBased sampler.
+//
+// The passed samplers override the sampler used for each parent case.
+func ConsistentParentBased(root Sampler, samplers ...ParentBasedSamplerOption) Sampler {
+	sampled := consistentParentSampler{sampled: true}
+	notSampled := consistentParentSampler{sampled: false}
+	opts := append([]ParentBasedSamplerOption{
+		WithRemoteParentSampled(sampled),
+		WithRemoteParentNotSampled(notSampled),
+		WithLocalParentSampled(sampled),
+		WithLocalParentNotSampled(notSampled),
+	}, samplers...)
+	return ParentBased(root, opts...)
+}
+
+// AdjustedCount returns the number of spans the span identified by sc
+// represents according to the OpenTelemetry consistent probability sampling
+// scheme. It returns 0 if the span is not sampled.
+//
+// The returned bool is false if the adjusted count cannot be determined
+// because the span is sampled but sc does not contain a valid threshold in
+// its trace state. In that case the returned count is 0.
+func AdjustedCount(sc trace.SpanContext) (float64, bool) {
+	if !sc.IsSampled() {
+		return 0, true
+	}
+	ots, err := parseOTelTraceState(sc.TraceState().Get(otelTraceStateKey))
+	if err != nil || !ots.hasThreshold {
+		return 0, false
+	}
+	return float64(maxThreshold) / float64(maxThreshold-ots.threshold), true
+}
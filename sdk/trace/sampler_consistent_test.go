@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestThresholdEncoding(t *testing.T) {
+	tests := []struct {
+		fraction  float64
+		threshold string
+	}{
+		{fraction: 1, threshold: "0"},
+		{fraction: 0.5, threshold: "8"},
+		{fraction: 0.25, threshold: "c"},
+		{fraction: 0.1, threshold: "e6666666666666"},
+		{fraction: 1.0 / 3, threshold: "aaaaaaaaaaaaac"},
+	}
+
+	for _, test := range tests {
+		th, ok := thresholdFromProbability(test.fraction)
+		require.True(t, ok)
+		assert.Equal(t, test.threshold, formatThreshold(th), "fraction %g", test.fraction)
+
+		parsed, err := parseThreshold(test.threshold)
+		require.NoError(t, err)
+		assert.Equal(t, th, parsed)
+	}
+
+	_, ok := thresholdFromProbability(0)
+	assert.False(t, ok)
+
+	for _, invalid := range []string{"", "g", "123456789abcdef"} {
+		_, err := parseThreshold(invalid)
+		assert.ErrorIs(t, err, errInvalidThreshold, invalid)
+	}
+}
+
+func TestParseOTelTraceState(t *testing.T) {
+	ots, err := parseOTelTraceState("th:8;rv:0123456789abcd;foo:bar")
+	require.NoError(t, err)
+	assert.True(t, ots.hasThreshold)
+	assert.Equal(t, maxThreshold/2, ots.threshold)
+	assert.True(t, ots.hasRandomness)
+	assert.Equal(t, uint64(0x0123456789abcd), ots.randomness)
+	assert.Equal(t, "th:8;rv:0123456789abcd;foo:bar", ots.String())
+
+	_, err = parseOTelTraceState("rv:123")
+	assert.ErrorIs(t, err, errInvalidRandomness)
+	_, err = parseOTelTraceState("invalid")
+	assert.ErrorIs(t, err, errInvalidOTelTraceState)
+}
+
+func consistentParams(t *testing.T, traceID trace.TraceID, parent trace.SpanContextConfig, ot string) SamplingParameters {
+	t.Helper()
+	var ts trace.TraceState
+	if ot != "" {
+		var err error
+		ts, err = ts.Insert(otelTraceStateKey, ot)
+		require.NoError(t, err)
+		ts, err = ts.Insert("vendor", "value")
+		require.NoError(t, err)
+	}
+	parent.TraceState = ts
+	return SamplingParameters{
+		ParentContext: trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(parent)),
+		TraceID:       traceID,
+	}
+}
+
+func TestConsistentProbabilityBased(t *testing.T) {
+	low := trace.TraceID{15: 0x01}
+	high := trace.TraceID{9: 0xff}
+
+	sampler := ConsistentProbabilityBased(0.5)
+	assert.Equal(t, "ConsistentProbabilityBased{0.5}", sampler.Description())
+
+	res := sampler.ShouldSample(consistentParams(t, high, trace.SpanContextConfig{}, ""))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "th:8", res.Tracestate.Get(otelTraceStateKey))
+
+	res = sampler.ShouldSample(consistentParams(t, low, trace.SpanContextConfig{}, ""))
+	assert.Equal(t, Drop, res.Decision)
+	assert.Equal(t, "", res.Tracestate.Get(otelTraceStateKey))
+
+	// Explicit randomness takes precedence over the trace ID.
+	res = sampler.ShouldSample(consistentParams(t, low, trace.SpanContextConfig{}, "rv:ffffffffffffff"))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "th:8;rv:ffffffffffffff", res.Tracestate.Get(otelTraceStateKey))
+	assert.Equal(t, "value", res.Tracestate.Get("vendor"), "other trace state entries should be kept")
+
+	// A dropped span does not carry a threshold.
+	res = sampler.ShouldSample(consistentParams(t, high, trace.SpanContextConfig{}, "th:0;rv:00000000000000;x:y"))
+	assert.Equal(t, Drop, res.Decision)
+	assert.Equal(t, "rv:00000000000000;x:y", res.Tracestate.Get(otelTraceStateKey))
+
+	assert.Equal(t, Drop, ConsistentProbabilityBased(0).ShouldSample(consistentParams(t, high, trace.SpanContextConfig{}, "")).Decision)
+	res = ConsistentProbabilityBased(1).ShouldSample(consistentParams(t, low, trace.SpanContextConfig{}, ""))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "th:0", res.Tracestate.Get(otelTraceStateKey))
+}
+
+func TestConsistentProbabilityBasedRatio(t *testing.T) {
+	const (
+		fraction = 0.25
+		n        = 10000
+	)
+	sampler := ConsistentProbabilityBased(fraction)
+	gen := defaultIDGenerator()
+	var sampled int
+	for i := 0; i < n; i++ {
+		traceID, _ := gen.NewIDs(context.Background())
+		if sampler.ShouldSample(SamplingParameters{TraceID: traceID}).Decision == RecordAndSample {
+			sampled++
+		}
+	}
+	assert.InDelta(t, fraction*n, sampled, 0.05*n)
+}
+
+func TestConsistentParentBased(t *testing.T) {
+	traceID := trace.TraceID{9: 0xd0}
+	sampled := trace.SpanContextConfig{TraceID: traceID, SpanID: sid, TraceFlags: trace.FlagsSampled}
+	notSampled := trace.SpanContextConfig{TraceID: traceID, SpanID: sid}
+
+	sampler := ConsistentParentBased(ConsistentProbabilityBased(0.5))
+
+	res := sampler.ShouldSample(consistentParams(t, traceID, sampled, "th:c"))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "th:c", res.Tracestate.Get(otelTraceStateKey), "consistent threshold should be propagated")
+
+	res = sampler.ShouldSample(consistentParams(t, traceID, sampled, "th:e"))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "", res.Tracestate.Get(otelTraceStateKey), "inconsistent threshold should be erased")
+
+	res = sampler.ShouldSample(consistentParams(t, traceID, notSampled, "th:c;rv:ffffffffffffff"))
+	assert.Equal(t, Drop, res.Decision)
+	assert.Equal(t, "rv:ffffffffffffff", res.Tracestate.Get(otelTraceStateKey))
+
+	sampled.Remote, notSampled.Remote = true, true
+	assert.Equal(t, RecordAndSample, sampler.ShouldSample(consistentParams(t, traceID, sampled, "")).Decision)
+	assert.Equal(t, Drop, sampler.ShouldSample(consistentParams(t, traceID, notSampled, "")).Decision)
+
+	// Without a parent the root sampler is used.
+	res = sampler.ShouldSample(SamplingParameters{TraceID: trace.TraceID{9: 0xff}})
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "th:8", res.Tracestate.Get(otelTraceStateKey))
+}
+
+func TestAdjustedCount(t *testing.T) {
+	newSC := func(flags trace.TraceFlags, ot string) trace.SpanContext {
+		var ts trace.TraceState
+		if ot != "" {
+			ts, _ = ts.Insert(otelTraceStateKey, ot)
+		}
+		return trace.NewSpanContext(trace.SpanContextConfig{TraceID: tid, SpanID: sid, TraceFlags: flags, TraceState: ts})
+	}
+
+	tests := []struct {
+		name  string
+		sc    trace.SpanContext
+		count float64
+		known bool
+	}{
+		{name: "not sampled", sc: newSC(0, "th:8"), count: 0, known: true},
+		{name: "always", sc: newSC(trace.FlagsSampled, "th:0"), count: 1, known: true},
+		{name: "half", sc: newSC(trace.FlagsSampled, "th:8"), count: 2, known: true},
+		{name: "quarter", sc: newSC(trace.FlagsSampled, "th:c;rv:00000000000001"), count: 4, known: true},
+		{name: "unknown", sc: newSC(trace.FlagsSampled, ""), count: 0, known: false},
+		{name: "invalid", sc: newSC(trace.FlagsSampled, "th:xyz"), count: 0, known: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			count, known := AdjustedCount(test.sc)
+			assert.Equal(t, test.known, known)
+			assert.InDelta(t, test.count, count, 1e-9)
+		})
+	}
+}
+
+func TestConsistentSamplingWithTracerProvider(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithSampler(ConsistentParentBased(ConsistentProbabilityBased(1))),
+	)
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	_, child := tp.Tracer("test").Start(ctx, "child")
+	child.End()
+	parent.End()
+
+	require.Len(t, te.Spans(), 2)
+	for _, s := range te.Spans() {
+		count, known := AdjustedCount(s.SpanContext())
+		assert.True(t, known, s.Name())
+		assert.Equal(t, 1.0, count, s.Name())
+	}
+}
@@ -9,6 +9,9 @@ import (
 	"os"
 	"strconv"
 	"strings"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
 )
 
 const (
@@ -21,6 +24,10 @@ const (
 	samplerParentBasedAlwaysOn     = "parentbased_always_on"
 	samplerParsedBasedAlwaysOff    = "parentbased_always_off"
 	samplerParentBasedTraceIDRatio = "parentbased_traceidratio"
+	samplerRateLimited             = "ratelimited"
+	samplerParentBasedRateLimited  = "parentbased_ratelimited"
+	samplerJaegerRemote            = "jaeger_remote"
+	samplerParentBasedJaegerRemote = "parentbased_jaeger_remote"
 )
 
 type errUnsupportedSampler string
@@ -32,6 +39,8 @@ func (e errUnsupportedSampler) Error() string {
 var (
 	errNegativeTraceIDRatio       = errors.New("invalid trace ID ratio: less than 0.0")
 	errGreaterThanOneTraceIDRatio = errors.New("invalid trace ID ratio: greater than 1.0")
+	errNegativeRateLimit          = errors.New("invalid rate limit: less than 0")
+	errNegativeBurst              = errors.New("invalid rate limit burst: less than 0")
 )
 
 type samplerArgParseError struct {
@@ -46,7 +55,12 @@ func (e samplerArgParseError) Unwrap() error {
 	return e.parseErr
 }
 
-func samplerFromEnv() (Sampler, error) {
+// samplerFromEnv returns the Sampler defined by the environment. The res is
+// the Resource of the TracerProvider the Sampler is used by.
+//
+// If startRemote is false, a sampler that polls a remote endpoint is not
+// created and nil is returned for it, its argument is only validated.
+func samplerFromEnv(res *resource.Resource, startRemote bool) (Sampler, error) {
 	sampler, ok := os.LookupEnv(tracesSamplerKey)
 	if !ok {
 		return nil, nil
@@ -76,6 +90,30 @@ func samplerFromEnv() (Sampler, error) {
 		}
 		ratio, err := parseTraceIDRatio(samplerArg)
 		return ParentBased(ratio), err
+	case samplerRateLimited:
+		if !hasSamplerArg {
+			return RateLimited(defaultRateLimit, 0), nil
+		}
+		return parseRateLimited(samplerArg)
+	case samplerParentBasedRateLimited:
+		if !hasSamplerArg {
+			return ParentBased(RateLimited(defaultRateLimit, 0)), nil
+		}
+		limited, err := parseRateLimited(samplerArg)
+		return ParentBased(limited), err
+	case samplerJaegerRemote:
+		if !startRemote {
+			_, err := parseJaegerRemoteArgs(samplerArg)
+			return nil, err
+		}
+		return jaegerRemoteSamplerFromEnv(res, samplerArg)
+	case samplerParentBasedJaegerRemote:
+		if !startRemote {
+			_, err := parseJaegerRemoteArgs(samplerArg)
+			return nil, err
+		}
+		s, err := jaegerRemoteSamplerFromEnv(res, samplerArg)
+		return ParentBased(s), err
 	default:
 		return nil, errUnsupportedSampler(sampler)
 	}
@@ -95,3 +133,49 @@ func parseTraceIDRatio(arg string) (Sampler, error) {
 
 	return TraceIDRatioBased(v), nil
 }
+
+// defaultRateLimit is the number of traces per second sampled by the
+// ratelimited samplers when no sampler argument is provided.
+const defaultRateLimit = 1.0
+
+// parseRateLimited returns a RateLimited sampler configured by arg. The arg
+// is the number of traces per second to sample, optionally followed by a
+// comma and the burst size.
+func parseRateLimited(arg string) (Sampler, error) {
+	rateArg, burstArg, hasBurst := strings.Cut(arg, ",")
+	rate, err := strconv.ParseFloat(strings.TrimSpace(rateArg), 64)
+	if err != nil {
+		return RateLimited(defaultRateLimit, 0), samplerArgParseError{err}
+	}
+	if rate < 0 {
+		return RateLimited(defaultRateLimit, 0), errNegativeRateLimit
+	}
+	if !hasBurst {
+		return RateLimited(rate, 0), nil
+	}
+
+	burst, err := strconv.Atoi(strings.TrimSpace(burstArg))
+	if err != nil {
+		return RateLimited(rate, 0), samplerArgParseError{err}
+	}
+	if burst < 0 {
+		return RateLimited(rate, 0), errNegativeBurst
+	}
+	return RateLimited(rate, burst), nil
+}
+
+// jaegerRemoteSamplerFromEnv returns a JaegerRemoteSampler configured with
+// arg for the service identified by res.
+func jaegerRemoteSamplerFromEnv(res *resource.Resource, arg string) (Sampler, error) {
+	opts, err := parseJaegerRemoteArgs(arg)
+	return NewJaegerRemoteSampler(serviceName(res), opts...), err
+}
+
+// serviceName returns the service name of res, or an empty string if res
+// does not define one.
+func serviceName(res *resource.Resource) string {
+	if v, ok := res.Set().Value(semconv.ServiceNameKey); ok {
+		return v.AsString()
+	}
+	return ""
+}
@@ -0,0 +1,558 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// Defaults for the JaegerRemoteSampler.
+const (
+	DefaultJaegerRemoteEndpoint        = "http://localhost:5778/sampling"
+	DefaultJaegerRemotePollingInterval = time.Minute
+	DefaultJaegerRemoteSamplingRate    = 0.001
+	DefaultJaegerRemoteMaxOperations   = 2000
+	DefaultJaegerRemoteMaxFailedPolls  = 5
+)
+
+// JaegerRemoteSampler is a Sampler that periodically polls a Jaeger
+// compatible sampling strategy endpoint and delegates its sampling decisions
+// to a Sampler built from the last strategy received.
+//
+// Probabilistic, rate-limiting, and per-operation strategies are supported.
+// Until a strategy has been successfully received from the endpoint, the
+// default Sampler is used to make sampling decisions. If the endpoint becomes
+// unreachable after a strategy has been received, the last received strategy
+// continues to be used until the configured number of consecutive polls
+// failed. The default Sampler is then used again until a strategy is
+// received.
+//
+// The polling of the endpoint is done in a background goroutine that is
+// stopped by calling Shutdown.
+type JaegerRemoteSampler struct {
+	serviceName string
+	cfg         jaegerRemoteConfig
+
+	// sampler holds the Sampler currently used to make sampling decisions.
+	sampler atomic.Pointer[samplerHolder]
+	// lastStrategy is the raw response of the last successfully applied
+	// strategy. It is only accessed from the polling goroutine.
+	lastStrategy []byte
+	// failedPolls is the number of consecutive failed polls. It is only
+	// accessed from the polling goroutine.
+	failedPolls int
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	stopWait sync.WaitGroup
+}
+
+var _ Sampler = (*JaegerRemoteSampler)(nil)
+
+// samplerHolder wraps a Sampler so it can be atomically swapped.
+type samplerHolder struct {
+	s Sampler
+}
+
+// NewJaegerRemoteSampler returns a JaegerRemoteSampler for the service named
+// serviceName configured with the passed opts. The returned sampler
+// immediately starts polling the configured endpoint for sampling
+// strategies.
+//
+// By default the returned sampler is configured with:
+//   - the "http://localhost:5778/sampling" endpoint
+//   - a polling interval of one minute
+//   - a TraceIDRatioBased(0.001) default Sampler
+//   - a maximum of 2000 operations tracked for per-operation strategies
+//   - a fallback to the default Sampler after 5 consecutive failed polls
+func NewJaegerRemoteSampler(serviceName string, opts ...JaegerRemoteSamplerOption) *JaegerRemoteSampler {
+	cfg := newJaegerRemoteConfig(opts)
+	s := &JaegerRemoteSampler{
+		serviceName: serviceName,
+		cfg:         cfg,
+		stopCh:      make(chan struct{}),
+	}
+	s.sampler.Store(&samplerHolder{s: cfg.defaultSampler})
+
+	s.stopWait.Add(1)
+	go func() {
+		defer s.stopWait.Done()
+		s.poll()
+	}()
+
+	return s
+}
+
+// ShouldSample returns a SamplingResult based on the sampling strategy most
+// recently received from the endpoint.
+func (s *JaegerRemoteSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	return s.sampler.Load().s.ShouldSample(p)
+}
+
+// Description returns information describing the JaegerRemoteSampler and the
+// Sampler it currently delegates to.
+func (s *JaegerRemoteSampler) Description() string {
+	return fmt.Sprintf("JaegerRemoteSampler{%s}", s.sampler.Load().s.Description())
+}
+
+// Shutdown stops the polling of the sampling strategy endpoint and waits for
+// it to end. The last received strategy continues to be used to make sampling
+// decisions.
+//
+// If ctx is done before the polling ended, the error of ctx is returned and
+// Shutdown can be called again to wait for the polling to end.
+func (s *JaegerRemoteSampler) Shutdown(ctx context.Context) error {
+	s.stopOnce.Do(func() { close(s.stopCh) })
+
+	wait := make(chan struct{})
+	go func() {
+		s.stopWait.Wait()
+		close(wait)
+	}()
+	select {
+	case <-wait:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// poll fetches the sampling strategy from the endpoint every polling
+// interval until the sampler is shut down.
+func (s *JaegerRemoteSampler) poll() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(s.cfg.pollingInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.update(ctx); err != nil && ctx.Err() == nil {
+			otel.Handle(err)
+			s.failed()
+		} else {
+			s.failedPolls = 0
+		}
+
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// failed accounts for a failed poll. Once the configured number of
+// consecutive polls failed, the default Sampler is used again.
+func (s *JaegerRemoteSampler) failed() {
+	s.failedPolls++
+	if s.failedPolls != s.cfg.maxFailedPolls || s.lastStrategy == nil {
+		return
+	}
+	s.lastStrategy = nil
+	s.sampler.Store(&samplerHolder{s: s.cfg.defaultSampler})
+}
+
+// update fetches the current sampling strategy and, if it changed, swaps the
+// Sampler used to make sampling decisions.
+func (s *JaegerRemoteSampler) update(ctx context.Context) error {
+	body, err := s.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if s.lastStrategy != nil && bytes.Equal(body, s.lastStrategy) {
+		return nil
+	}
+
+	var strategy jaegerSamplingStrategy
+	if err := json.Unmarshal(body, &strategy); err != nil {
+		return fmt.Errorf("jaeger remote sampler: parsing sampling strategy: %w", err)
+	}
+	sampler, err := strategy.sampler(s.cfg)
+	if err != nil {
+		return err
+	}
+
+	s.lastStrategy = body
+	s.sampler.Store(&samplerHolder{s: sampler})
+	return nil
+}
+
+// fetch returns the raw sampling strategy for the service from the endpoint.
+func (s *JaegerRemoteSampler) fetch(ctx context.Context) ([]byte, error) {
+	u, err := url.Parse(s.cfg.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("jaeger remote sampler: invalid endpoint: %w", err)
+	}
+	q := u.Query()
+	q.Set("service", s.serviceName)
+	u.RawQuery = q.Encode()
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.pollingInterval)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("jaeger remote sampler: %w", err)
+	}
+	resp, err := s.cfg.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("jaeger remote sampler: fetching sampling strategy: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("jaeger remote sampler: reading sampling strategy: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jaeger remote sampler: fetching sampling strategy: %s", resp.Status)
+	}
+	return body, nil
+}
+
+// jaegerRemoteConfig is a group of options for a JaegerRemoteSampler.
+type jaegerRemoteConfig struct {
+	endpoint        string
+	pollingInterval time.Duration
+	defaultSampler  Sampler
+	maxOperations   int
+	maxFailedPolls  int
+	client          *http.Client
+}
+
+func newJaegerRemoteConfig(opts []JaegerRemoteSamplerOption) jaegerRemoteConfig {
+	c := jaegerRemoteConfig{
+		endpoint:        DefaultJaegerRemoteEndpoint,
+		pollingInterval: DefaultJaegerRemotePollingInterval,
+		defaultSampler:  TraceIDRatioBased(DefaultJaegerRemoteSamplingRate),
+		maxOperations:   DefaultJaegerRemoteMaxOperations,
+		maxFailedPolls:  DefaultJaegerRemoteMaxFailedPolls,
+		client:          http.DefaultClient,
+	}
+	for _, o := range opts {
+		c = o.apply(c)
+	}
+	return c
+}
+
+// JaegerRemoteSamplerOption configures a JaegerRemoteSampler.
+type JaegerRemoteSamplerOption interface {
+	apply(jaegerRemoteConfig) jaegerRemoteConfig
+}
+
+type jaegerRemoteOptionFunc func(jaegerRemoteConfig) jaegerRemoteConfig
+
+func (fn jaegerRemoteOptionFunc) apply(c jaegerRemoteConfig) jaegerRemoteConfig {
+	return fn(c)
+}
+
+// WithJaegerRemoteEndpoint sets the URL of the sampling strategy endpoint
+// polled by a JaegerRemoteSampler. The service name is added to the URL as
+// the "service" query parameter.
+func WithJaegerRemoteEndpoint(endpoint string) JaegerRemoteSamplerOption {
+	return jaegerRemoteOptionFunc(func(c jaegerRemoteConfig) jaegerRemoteConfig {
+		c.endpoint = endpoint
+		return c
+	})
+}
+
+// WithJaegerRemotePollingInterval sets the interval a JaegerRemoteSampler
+// waits between polls of the sampling strategy endpoint. Non-positive values
+// are ignored.
+func WithJaegerRemotePollingInterval(interval time.Duration) JaegerRemoteSamplerOption {
+	return jaegerRemoteOptionFunc(func(c jaegerRemoteConfig) jaegerRemoteConfig {
+		if interval > 0 {
+			c.pollingInterval = interval
+		}
+		return c
+	})
+}
+
+// WithJaegerRemoteDefaultSampler sets the Sampler a JaegerRemoteSampler uses
+// until a sampling strategy is received from the endpoint, and after too many
+// consecutive polls of the endpoint failed.
+func WithJaegerRemoteDefaultSampler(s Sampler) JaegerRemoteSamplerOption {
+	return jaegerRemoteOptionFunc(func(c jaegerRemoteConfig) jaegerRemoteConfig {
+		if s != nil {
+			c.defaultSampler = s
+		}
+		return c
+	})
+}
+
+// WithJaegerRemoteMaxOperations sets the maximum number of operations a
+// JaegerRemoteSampler tracks individually when using a per-operation
+// strategy. Spans for operations beyond this limit are sampled using the
+// default sampling probability of the strategy. Non-positive values are
+// ignored.
+func WithJaegerRemoteMaxOperations(n int) JaegerRemoteSamplerOption {
+	return jaegerRemoteOptionFunc(func(c jaegerRemoteConfig) jaegerRemoteConfig {
+		if n > 0 {
+			c.maxOperations = n
+		}
+		return c
+	})
+}
+
+// WithJaegerRemoteMaxFailedPolls sets the number of consecutive failed polls
+// of the sampling strategy endpoint after which a JaegerRemoteSampler stops
+// using the last received strategy and uses its default Sampler again.
+// Non-positive values are ignored.
+func WithJaegerRemoteMaxFailedPolls(n int) JaegerRemoteSamplerOption {
+	return jaegerRemoteOptionFunc(func(c jaegerRemoteConfig) jaegerRemoteConfig {
+		if n > 0 {
+			c.maxFailedPolls = n
+		}
+		return c
+	})
+}
+
+// WithJaegerRemoteHTTPClient sets the HTTP client a JaegerRemoteSampler uses
+// to poll the sampling strategy endpoint.
+func WithJaegerRemoteHTTPClient(client *http.Client) JaegerRemoteSamplerOption {
+	return jaegerRemoteOptionFunc(func(c jaegerRemoteConfig) jaegerRemoteConfig {
+		if client != nil {
+			c.client = client
+		}
+		return c
+	})
+}
+
+// jaegerStrategyType is the type of a sampling strategy. It is encoded either
+// as a string or, by older agents, as its Thrift enum value.
+type jaegerStrategyType int
+
+const (
+	jaegerProbabilistic jaegerStrategyType = iota
+	jaegerRateLimiting
+)
+
+func (t *jaegerStrategyType) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		switch strings.ToUpper(name) {
+		case "PROBABILISTIC":
+			*t = jaegerProbabilistic
+		case "RATE_LIMITING":
+			*t = jaegerRateLimiting
+		default:
+			return fmt.Errorf("unknown sampling strategy type: %q", name)
+		}
+		return nil
+	}
+
+	var v int
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*t = jaegerStrategyType(v)
+	return nil
+}
+
+// jaegerSamplingStrategy is the sampling strategy response of a Jaeger
+// sampling strategy endpoint.
+type jaegerSamplingStrategy struct {
+	StrategyType          jaegerStrategyType `json:"strategyType"`
+	ProbabilisticSampling *struct {
+		SamplingRate float64 `json:"samplingRate"`
+	} `json:"probabilisticSampling"`
+	RateLimitingSampling *struct {
+		MaxTracesPerSecond float64 `json:"maxTracesPerSecond"`
+	} `json:"rateLimitingSampling"`
+	OperationSampling *struct {
+		DefaultSamplingProbability       float64 `json:"defaultSamplingProbability"`
+		DefaultLowerBoundTracesPerSecond float64 `json:"defaultLowerBoundTracesPerSecond"`
+		PerOperationStrategies           []struct {
+			Operation             string `json:"operation"`
+			ProbabilisticSampling struct {
+				SamplingRate float64 `json:"samplingRate"`
+			} `json:"probabilisticSampling"`
+		} `json:"perOperationStrategies"`
+	} `json:"operationSampling"`
+}
+
+var errJaegerNoStrategy = errors.New("jaeger remote sampler: sampling strategy response contains no strategy")
+
+// sampler returns the Sampler implementing the strategy.
+func (s jaegerSamplingStrategy) sampler(cfg jaegerRemoteConfig) (Sampler, error) {
+	if op := s.OperationSampling; op != nil {
+		pos := &perOperationSampler{
+			defaultRate:       op.DefaultSamplingProbability,
+			lowerBound:        op.DefaultLowerBoundTracesPerSecond,
+			maxOperations:     cfg.maxOperations,
+			defaultSampler:    TraceIDRatioBased(op.DefaultSamplingProbability),
+			operationSamplers: make(map[string]Sampler, len(op.PerOperationStrategies)),
+		}
+		for _, strategy := range op.PerOperationStrategies {
+			if len(pos.operationSamplers) >= pos.maxOperations {
+				break
+			}
+			pos.operationSamplers[strategy.Operation] = newGuaranteedThroughputSampler(
+				strategy.ProbabilisticSampling.SamplingRate,
+				pos.lowerBound,
+			)
+		}
+		return pos, nil
+	}
+
+	switch s.StrategyType {
+	case jaegerProbabilistic:
+		if s.ProbabilisticSampling == nil {
+			return nil, errJaegerNoStrategy
+		}
+		return TraceIDRatioBased(s.ProbabilisticSampling.SamplingRate), nil
+	case jaegerRateLimiting:
+		if s.RateLimitingSampling == nil {
+			return nil, errJaegerNoStrategy
+		}
+		return RateLimited(s.RateLimitingSampling.MaxTracesPerSecond, 0), nil
+	default:
+		return nil, fmt.Errorf("jaeger remote sampler: unknown sampling strategy type: %d", s.StrategyType)
+	}
+}
+
+// guaranteedThroughputSampler samples traces probabilistically while
+// guaranteeing a minimum number of sampled traces per second.
+type guaranteedThroughputSampler struct {
+	probabilistic Sampler
+	lowerBound    *rateLimiter
+	description   string
+}
+
+func newGuaranteedThroughputSampler(rate, lowerBound float64) *guaranteedThroughputSampler {
+	return &guaranteedThroughputSampler{
+		probabilistic: TraceIDRatioBased(rate),
+		lowerBound:    newRateLimiter(lowerBound, max(lowerBound, 1)),
+		description:   fmt.Sprintf("GuaranteedThroughputSampler{rate:%g,lowerBound:%g}", rate, lowerBound),
+	}
+}
+
+func (gs *guaranteedThroughputSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	res := gs.probabilistic.ShouldSample(p)
+	// Always consult the lower bound limiter so sampled traces are accounted
+	// for in its balance.
+	if gs.lowerBound.allow(1) {
+		res.Decision = RecordAndSample
+	}
+	return res
+}
+
+func (gs *guaranteedThroughputSampler) Description() string {
+	return gs.description
+}
+
+// perOperationSampler samples traces using a guaranteedThroughputSampler per
+// span name.
+type perOperationSampler struct {
+	defaultRate    float64
+	lowerBound     float64
+	maxOperations  int
+	defaultSampler Sampler
+
+	mu                sync.RWMutex
+	operationSamplers map[string]Sampler
+}
+
+func (ps *perOperationSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	return ps.samplerFor(p.Name).ShouldSample(p)
+}
+
+// samplerFor returns the Sampler for operation. A new Sampler is created for
+// operations not yet seen as long as the maximum number of operations has not
+// been reached.
+func (ps *perOperationSampler) samplerFor(operation string) Sampler {
+	ps.mu.RLock()
+	s, ok := ps.operationSamplers[operation]
+	ps.mu.RUnlock()
+	if ok {
+		return s
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if s, ok = ps.operationSamplers[operation]; ok {
+		return s
+	}
+	if len(ps.operationSamplers) >= ps.maxOperations {
+		return ps.defaultSampler
+	}
+	s = newGuaranteedThroughputSampler(ps.defaultRate, ps.lowerBound)
+	ps.operationSamplers[operation] = s
+	return s
+}
+
+func (ps *perOperationSampler) Description() string {
+	return fmt.Sprintf("PerOperationSampler{defaultRate:%g,lowerBound:%g}", ps.defaultRate, ps.lowerBound)
+}
+
+// Keys of the OTEL_TRACES_SAMPLER_ARG value for the jaeger_remote samplers.
+const (
+	jaegerRemoteArgEndpoint            = "endpoint"
+	jaegerRemoteArgPollingIntervalMs   = "pollingIntervalMs"
+	jaegerRemoteArgInitialSamplingRate = "initialSamplingRate"
+)
+
+// parseJaegerRemoteArgs parses the comma separated list of key=value pairs
+// of the OTEL_TRACES_SAMPLER_ARG value for the jaeger_remote samplers. All
+// valid options are returned along with an error for any invalid pair.
+func parseJaegerRemoteArgs(arg string) ([]JaegerRemoteSamplerOption, error) {
+	var (
+		opts []JaegerRemoteSamplerOption
+		errs []error
+	)
+	for _, pair := range strings.Split(arg, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			errs = append(errs, samplerArgParseError{fmt.Errorf("invalid key-value pair: %q", pair)})
+			continue
+		}
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+
+		switch k {
+		case jaegerRemoteArgEndpoint:
+			opts = append(opts, WithJaegerRemoteEndpoint(v))
+		case jaegerRemoteArgPollingIntervalMs:
+			ms, err := strconv.Atoi(v)
+			if err != nil {
+				errs = append(errs, samplerArgParseError{err})
+				continue
+			}
+			opts = append(opts, WithJaegerRemotePollingInterval(time.Duration(ms)*time.Millisecond))
+		case jaegerRemoteArgInitialSamplingRate:
+			ratio, err := parseTraceIDRatio(v)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			opts = append(opts, WithJaegerRemoteDefaultSampler(ratio))
+		default:
+			errs = append(errs, samplerArgParseError{fmt.Errorf("unknown key: %q", k)})
+		}
+	}
+	return opts, errors.Join(errs...)
+}
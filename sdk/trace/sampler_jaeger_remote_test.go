@@ -0,0 +1,342 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ottest "go.opentelemetry.io/otel/sdk/internal/internaltest"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type strategyServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	strategy string
+	services []string
+}
+
+func newStrategyServer(t *testing.T, strategy string) *strategyServer {
+	s := &strategyServer{strategy: strategy}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.services = append(s.services, r.URL.Query().Get("service"))
+		if s.strategy == "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(s.strategy))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *strategyServer) setStrategy(strategy string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.strategy = strategy
+}
+
+func newTestJaegerRemoteSampler(t *testing.T, endpoint string, opts ...JaegerRemoteSamplerOption) *JaegerRemoteSampler {
+	opts = append([]JaegerRemoteSamplerOption{
+		WithJaegerRemoteEndpoint(endpoint),
+		WithJaegerRemotePollingInterval(10 * time.Millisecond),
+	}, opts...)
+	s := NewJaegerRemoteSampler("test-service", opts...)
+	t.Cleanup(func() {
+		require.NoError(t, s.Shutdown(context.Background()))
+		handler.Reset()
+	})
+	return s
+}
+
+func TestJaegerRemoteSamplerStrategies(t *testing.T) {
+	tests := []struct {
+		name        string
+		strategy    string
+		description string
+	}{
+		{
+			name:        "probabilistic",
+			strategy:    `{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":0.5}}`,
+			description: "JaegerRemoteSampler{TraceIDRatioBased{0.5}}",
+		},
+		{
+			name:        "probabilistic thrift enum",
+			strategy:    `{"strategyType":0,"probabilisticSampling":{"samplingRate":0.25}}`,
+			description: "JaegerRemoteSampler{TraceIDRatioBased{0.25}}",
+		},
+		{
+			name:        "rate limiting",
+			strategy:    `{"strategyType":"RATE_LIMITING","rateLimitingSampling":{"maxTracesPerSecond":10}}`,
+			description: "JaegerRemoteSampler{RateLimited{10,burst:10}}",
+		},
+		{
+			name: "per operation",
+			strategy: `{"strategyType":"PROBABILISTIC","operationSampling":{
+				"defaultSamplingProbability":0.1,
+				"defaultLowerBoundTracesPerSecond":2,
+				"perOperationStrategies":[{"operation":"op","probabilisticSampling":{"samplingRate":1}}]}}`,
+			description: "JaegerRemoteSampler{PerOperationSampler{defaultRate:0.1,lowerBound:2}}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newStrategyServer(t, test.strategy)
+			s := newTestJaegerRemoteSampler(t, srv.URL)
+			assert.Eventually(t, func() bool {
+				return s.Description() == test.description
+			}, time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestJaegerRemoteSamplerServiceName(t *testing.T) {
+	srv := newStrategyServer(t, `{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":1}}`)
+	s := newTestJaegerRemoteSampler(t, srv.URL)
+	require.Eventually(t, func() bool {
+		return s.Description() == "JaegerRemoteSampler{AlwaysOnSampler}"
+	}, time.Second, 10*time.Millisecond)
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	require.NotEmpty(t, srv.services)
+	assert.Equal(t, "test-service", srv.services[0])
+}
+
+func TestJaegerRemoteSamplerDefault(t *testing.T) {
+	srv := newStrategyServer(t, "")
+	s := newTestJaegerRemoteSampler(
+		t, srv.URL,
+		WithJaegerRemoteDefaultSampler(NeverSample()),
+		WithJaegerRemoteMaxFailedPolls(1000),
+	)
+
+	params := SamplingParameters{TraceID: tid}
+	assert.Equal(t, Drop, s.ShouldSample(params).Decision)
+	assert.Equal(t, "JaegerRemoteSampler{AlwaysOffSampler}", s.Description())
+
+	// Recover once the endpoint is reachable.
+	srv.setStrategy(`{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":1}}`)
+	require.Eventually(t, func() bool {
+		return s.ShouldSample(params).Decision == RecordAndSample
+	}, time.Second, 10*time.Millisecond)
+
+	// Keep the last strategy if the endpoint becomes unreachable.
+	srv.setStrategy("")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, RecordAndSample, s.ShouldSample(params).Decision)
+}
+
+func TestJaegerRemoteSamplerFallback(t *testing.T) {
+	srv := newStrategyServer(t, `{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":1}}`)
+	s := newTestJaegerRemoteSampler(
+		t, srv.URL,
+		WithJaegerRemoteDefaultSampler(NeverSample()),
+		WithJaegerRemoteMaxFailedPolls(2),
+	)
+
+	params := SamplingParameters{TraceID: tid}
+	require.Eventually(t, func() bool {
+		return s.ShouldSample(params).Decision == RecordAndSample
+	}, time.Second, 10*time.Millisecond)
+
+	// Use the default Sampler once too many polls failed.
+	srv.setStrategy("")
+	require.Eventually(t, func() bool {
+		return s.ShouldSample(params).Decision == Drop
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "JaegerRemoteSampler{AlwaysOffSampler}", s.Description())
+
+	// Apply the strategy again once the endpoint recovers.
+	srv.setStrategy(`{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":1}}`)
+	require.Eventually(t, func() bool {
+		return s.ShouldSample(params).Decision == RecordAndSample
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestJaegerRemoteSamplerShutdown(t *testing.T) {
+	srv := newStrategyServer(t, "")
+	s := NewJaegerRemoteSampler("test-service", WithJaegerRemoteEndpoint(srv.URL))
+	require.NoError(t, s.Shutdown(context.Background()))
+	// Subsequent calls must not panic or block.
+	require.NoError(t, s.Shutdown(context.Background()))
+	handler.Reset()
+}
+
+func TestJaegerRemoteSamplerShutdownRetry(t *testing.T) {
+	srv := newStrategyServer(t, "")
+	s := NewJaegerRemoteSampler("test-service", WithJaegerRemoteEndpoint(srv.URL))
+	t.Cleanup(handler.Reset)
+
+	// Simulate polling that does not end before ctx is done.
+	s.stopWait.Add(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, s.Shutdown(ctx), context.Canceled)
+
+	s.stopWait.Done()
+	assert.NoError(t, s.Shutdown(context.Background()), "retried shutdown")
+}
+
+func TestTracerProviderJaegerRemoteSamplerFromEnv(t *testing.T) {
+	srv := newStrategyServer(t, `{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":1}}`)
+	envStore, err := ottest.SetEnvVariables(map[string]string{
+		"OTEL_TRACES_SAMPLER":     "jaeger_remote",
+		"OTEL_TRACES_SAMPLER_ARG": "endpoint=" + srv.URL + ",pollingIntervalMs=10",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, envStore.Restore()) })
+	t.Cleanup(handler.Reset)
+
+	requests := func() int {
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+		return len(srv.services)
+	}
+
+	t.Run("WithSampler", func(t *testing.T) {
+		tp := NewTracerProvider(WithSampler(NeverSample()))
+		t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, 0, requests(), "overridden env sampler polled")
+	})
+
+	t.Run("Shutdown", func(t *testing.T) {
+		res := resource.NewSchemaless(semconv.ServiceName("resource-service"))
+		tp := NewTracerProvider(WithResource(res))
+		require.Eventually(t, func() bool {
+			return requests() > 0
+		}, time.Second, 10*time.Millisecond)
+
+		srv.mu.Lock()
+		assert.Equal(t, "resource-service", srv.services[0])
+		srv.mu.Unlock()
+
+		jrs, ok := (*tp.sampler.Load()).(*JaegerRemoteSampler)
+		require.True(t, ok, "env sampler not used")
+		require.NoError(t, tp.Shutdown(context.Background()))
+		select {
+		case <-jrs.stopCh:
+		default:
+			t.Error("env sampler not shut down")
+		}
+	})
+}
+
+func TestGuaranteedThroughputSampler(t *testing.T) {
+	s := newGuaranteedThroughputSampler(0, 1)
+	params := SamplingParameters{TraceID: tid}
+	assert.Equal(t, RecordAndSample, s.ShouldSample(params).Decision, "lower bound should be guaranteed")
+	assert.Equal(t, Drop, s.ShouldSample(params).Decision)
+}
+
+func TestPerOperationSamplerMaxOperations(t *testing.T) {
+	s := &perOperationSampler{
+		defaultRate:       0,
+		lowerBound:        1,
+		maxOperations:     1,
+		defaultSampler:    NeverSample(),
+		operationSamplers: make(map[string]Sampler),
+	}
+	assert.Equal(t, RecordAndSample, s.ShouldSample(SamplingParameters{Name: "a"}).Decision)
+	assert.Equal(t, Drop, s.ShouldSample(SamplingParameters{Name: "b"}).Decision)
+	assert.Len(t, s.operationSamplers, 1)
+}
+
+func TestJaegerRemoteSamplerFromEnv(t *testing.T) {
+	srv := newStrategyServer(t, `{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":0.5}}`)
+
+	tests := []struct {
+		sampler string
+		arg     string
+		desc    string
+	}{
+		{
+			sampler: "jaeger_remote",
+			arg:     "endpoint=" + srv.URL + ",pollingIntervalMs=10,initialSamplingRate=0",
+			desc:    "JaegerRemoteSampler{TraceIDRatioBased{0.5}}",
+		},
+		{
+			sampler: "parentbased_jaeger_remote",
+			arg:     "endpoint=" + srv.URL + ",pollingIntervalMs=10",
+			desc: "ParentBased{root:JaegerRemoteSampler{TraceIDRatioBased{0.5}},remoteParentSampled:AlwaysOnSampler," +
+				"remoteParentNotSampled:AlwaysOffSampler,localParentSampled:AlwaysOnSampler,localParentNotSampled:AlwaysOffSampler}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.sampler, func(t *testing.T) {
+			envStore, err := ottest.SetEnvVariables(map[string]string{
+				"OTEL_TRACES_SAMPLER":     test.sampler,
+				"OTEL_TRACES_SAMPLER_ARG": test.arg,
+				"OTEL_SERVICE_NAME":       "env-service",
+			})
+			require.NoError(t, err)
+			t.Cleanup(func() { require.NoError(t, envStore.Restore()) })
+
+			s, err := samplerFromEnv(resource.Environment(), true)
+			require.NoError(t, err)
+
+			var jrs *JaegerRemoteSampler
+			switch v := s.(type) {
+			case *JaegerRemoteSampler:
+				jrs = v
+			case parentBased:
+				jrs = v.root.(*JaegerRemoteSampler)
+			}
+			require.NotNil(t, jrs)
+			t.Cleanup(func() { require.NoError(t, jrs.Shutdown(context.Background())) })
+
+			assert.Equal(t, "env-service", jrs.serviceName)
+			assert.Eventually(t, func() bool {
+				return s.Description() == test.desc
+			}, time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestParseJaegerRemoteArgs(t *testing.T) {
+	opts, err := parseJaegerRemoteArgs("endpoint=http://localhost:1234, pollingIntervalMs=500,initialSamplingRate=0.5")
+	require.NoError(t, err)
+	cfg := newJaegerRemoteConfig(opts)
+	assert.Equal(t, "http://localhost:1234", cfg.endpoint)
+	assert.Equal(t, 500*time.Millisecond, cfg.pollingInterval)
+	assert.Equal(t, TraceIDRatioBased(0.5).Description(), cfg.defaultSampler.Description())
+
+	opts, err = parseJaegerRemoteArgs("endpoint=http://localhost:1234,pollingIntervalMs=abc,unknown=1,initialSamplingRate=2")
+	assert.ErrorAs(t, err, new(samplerArgParseError))
+	assert.ErrorIs(t, err, errGreaterThanOneTraceIDRatio)
+	cfg = newJaegerRemoteConfig(opts)
+	assert.Equal(t, "http://localhost:1234", cfg.endpoint)
+	assert.Equal(t, DefaultJaegerRemotePollingInterval, cfg.pollingInterval)
+}
+
+func TestJaegerRemoteSamplerTraceState(t *testing.T) {
+	srv := newStrategyServer(t, `{"strategyType":"RATE_LIMITING","rateLimitingSampling":{"maxTracesPerSecond":1}}`)
+	s := newTestJaegerRemoteSampler(t, srv.URL)
+	require.Eventually(t, func() bool {
+		return s.Description() == "JaegerRemoteSampler{RateLimited{1,burst:1}}"
+	}, time.Second, 10*time.Millisecond)
+
+	parent := trace.NewSpanContext(trace.SpanContextConfig{TraceID: tid, SpanID: sid, TraceState: ts})
+	params := SamplingParameters{
+		ParentContext: trace.ContextWithSpanContext(context.Background(), parent),
+		TraceID:       tid,
+	}
+	assert.Equal(t, ts, s.ShouldSample(params).Tracestate)
+}
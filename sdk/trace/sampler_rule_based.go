@@ -0,0 +1,298 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	errNoRuleSampler = errors.New("sampling rule has no sampler")
+	errNoMatcherKey  = errors.New("attribute matcher has no key")
+)
+
+// ParentRequirement is the requirement a SamplingRule places on the parent of
+// a span.
+type ParentRequirement uint8
+
+const (
+	// ParentAny matches spans regardless of their parent.
+	ParentAny ParentRequirement = iota
+	// ParentNone matches root spans, spans without a valid parent.
+	ParentNone
+	// ParentPresent matches spans with a valid parent.
+	ParentPresent
+	// ParentLocal matches spans with a valid local parent.
+	ParentLocal
+	// ParentRemote matches spans with a valid remote parent.
+	ParentRemote
+)
+
+// String returns the name of the ParentRequirement.
+func (r ParentRequirement) String() string {
+	switch r {
+	case ParentAny:
+		return "any"
+	case ParentNone:
+		return "none"
+	case ParentPresent:
+		return "present"
+	case ParentLocal:
+		return "local"
+	case ParentRemote:
+		return "remote"
+	default:
+		return fmt.Sprintf("ParentRequirement(%d)", uint8(r))
+	}
+}
+
+func (r ParentRequirement) matches(psc trace.SpanContext) bool {
+	switch r {
+	case ParentNone:
+		return !psc.IsValid()
+	case ParentPresent:
+		return psc.IsValid()
+	case ParentLocal:
+		return psc.IsValid() && !psc.IsRemote()
+	case ParentRemote:
+		return psc.IsValid() && psc.IsRemote()
+	default:
+		return true
+	}
+}
+
+// AttributeMatcher matches an attribute a span is started with.
+type AttributeMatcher struct {
+	// Key is the key of the attribute to match. It is required.
+	Key attribute.Key
+	// Value, if valid, is the value the attribute is required to have.
+	Value attribute.Value
+	// ValueRegexp, if not empty, is a regular expression the string
+	// representation of the attribute value is required to match.
+	ValueRegexp string
+}
+
+// SamplingRule defines the criteria a span needs to match for its sampling
+// decision to be made by Sampler. A span matches the rule if it matches all
+// non-zero-value criteria fields of the rule.
+type SamplingRule struct {
+	// Name is matched against the span name. The "*" wildcard is recognized
+	// as matching zero or more characters, and "?" is recognized as matching
+	// exactly one character.
+	Name string
+	// NameRegexp is a regular expression the span name is required to match.
+	NameRegexp string
+	// Kinds are the span kinds matched. A span matches if its kind is any of
+	// Kinds.
+	Kinds []trace.SpanKind
+	// Attributes are matched against the attributes the span is started
+	// with. A span matches if it matches all of Attributes.
+	Attributes []AttributeMatcher
+	// Parent is the requirement on the parent of the span.
+	Parent ParentRequirement
+
+	// Sampler makes the sampling decision for the spans that match the rule.
+	// It is required.
+	Sampler Sampler
+}
+
+// RuleBasedSamplerConfig is the configuration of a RuleBased sampler.
+type RuleBasedSamplerConfig struct {
+	// Rules are evaluated in order. The Sampler of the first rule a span
+	// matches makes the sampling decision for the span.
+	Rules []SamplingRule
+	// Default makes the sampling decision for spans that match no rule. If
+	// nil, AlwaysSample is used.
+	Default Sampler
+}
+
+// RuleBased returns a Sampler that delegates the sampling decision of a span
+// to the Sampler of the first rule of cfg the span matches. If the span does
+// not match any rule, the default Sampler of cfg is used.
+//
+// An error is returned if any rule has no Sampler or contains an invalid
+// regular expression.
+func RuleBased(cfg RuleBasedSamplerConfig) (Sampler, error) {
+	rs := ruleBasedSampler{
+		rules:    make([]samplingRule, 0, len(cfg.Rules)),
+		fallback: cfg.Default,
+	}
+	if rs.fallback == nil {
+		rs.fallback = AlwaysSample()
+	}
+
+	var errs []error
+	for i, r := range cfg.Rules {
+		compiled, err := newSamplingRule(r)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("sampling rule %d: %w", i, err))
+			continue
+		}
+		rs.rules = append(rs.rules, compiled)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	rules := make([]string, len(rs.rules))
+	for i, r := range rs.rules {
+		rules[i] = r.description
+	}
+	rs.description = fmt.Sprintf("RuleBased{rules:[%s],default:%s}", strings.Join(rules, ","), rs.fallback.Description())
+	return rs, nil
+}
+
+type ruleBasedSampler struct {
+	rules       []samplingRule
+	fallback    Sampler
+	description string
+}
+
+func (rs ruleBasedSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	psc := trace.SpanContextFromContext(p.ParentContext)
+	for _, r := range rs.rules {
+		if r.matches(psc, p) {
+			return r.sampler.ShouldSample(p)
+		}
+	}
+	return rs.fallback.ShouldSample(p)
+}
+
+func (rs ruleBasedSampler) Description() string {
+	return rs.description
+}
+
+// samplingRule is a SamplingRule with its patterns compiled.
+type samplingRule struct {
+	name        *regexp.Regexp
+	nameRegexp  *regexp.Regexp
+	kinds       []trace.SpanKind
+	attrs       []attributeMatcher
+	parent      ParentRequirement
+	sampler     Sampler
+	description string
+}
+
+type attributeMatcher struct {
+	key         attribute.Key
+	value       attribute.Value
+	valueRegexp *regexp.Regexp
+}
+
+func newSamplingRule(r SamplingRule) (samplingRule, error) {
+	if r.Sampler == nil {
+		return samplingRule{}, errNoRuleSampler
+	}
+
+	sr := samplingRule{
+		kinds:   r.Kinds,
+		parent:  r.Parent,
+		sampler: r.Sampler,
+	}
+	var desc []string
+
+	if r.Name != "" {
+		pattern := regexp.QuoteMeta(r.Name)
+		pattern = "^" + pattern + "$"
+		pattern = strings.ReplaceAll(pattern, `\?`, ".")
+		pattern = strings.ReplaceAll(pattern, `\*`, ".*")
+		sr.name = regexp.MustCompile(pattern)
+		desc = append(desc, "name:"+r.Name)
+	}
+	if r.NameRegexp != "" {
+		re, err := regexp.Compile(r.NameRegexp)
+		if err != nil {
+			return samplingRule{}, err
+		}
+		sr.nameRegexp = re
+		desc = append(desc, "nameRegexp:"+r.NameRegexp)
+	}
+	if len(r.Kinds) > 0 {
+		kinds := make([]string, len(r.Kinds))
+		for i, k := range r.Kinds {
+			kinds[i] = k.String()
+		}
+		desc = append(desc, "kinds:["+strings.Join(kinds, ",")+"]")
+	}
+	for _, a := range r.Attributes {
+		if a.Key == "" {
+			return samplingRule{}, errNoMatcherKey
+		}
+		m := attributeMatcher{key: a.Key, value: a.Value}
+		d := string(a.Key)
+		if a.Value.Type() != attribute.INVALID {
+			d += "=" + a.Value.Emit()
+		}
+		if a.ValueRegexp != "" {
+			re, err := regexp.Compile(a.ValueRegexp)
+			if err != nil {
+				return samplingRule{}, err
+			}
+			m.valueRegexp = re
+			d += "~" + a.ValueRegexp
+		}
+		sr.attrs = append(sr.attrs, m)
+		desc = append(desc, "attribute:"+d)
+	}
+	if r.Parent != ParentAny {
+		desc = append(desc, "parent:"+r.Parent.String())
+	}
+	desc = append(desc, "sampler:"+r.Sampler.Description())
+
+	sr.description = "{" + strings.Join(desc, ",") + "}"
+	return sr, nil
+}
+
+func (r samplingRule) matches(psc trace.SpanContext, p SamplingParameters) bool {
+	if r.name != nil && !r.name.MatchString(p.Name) {
+		return false
+	}
+	if r.nameRegexp != nil && !r.nameRegexp.MatchString(p.Name) {
+		return false
+	}
+	if len(r.kinds) > 0 && !r.matchesKind(p.Kind) {
+		return false
+	}
+	for _, m := range r.attrs {
+		if !m.matches(p.Attributes) {
+			return false
+		}
+	}
+	return r.parent.matches(psc)
+}
+
+func (r samplingRule) matchesKind(kind trace.SpanKind) bool {
+	kind = trace.ValidateSpanKind(kind)
+	for _, k := range r.kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether attrs contains an attribute matching m. If attrs
+// contains duplicate keys, the last value is used.
+func (m attributeMatcher) matches(attrs []attribute.KeyValue) bool {
+	for i := len(attrs) - 1; i >= 0; i-- {
+		if attrs[i].Key != m.key {
+			continue
+		}
+		v := attrs[i].Value
+		if m.value.Type() != attribute.INVALID && v != m.value {
+			return false
+		}
+		if m.valueRegexp != nil && !m.valueRegexp.MatchString(v.Emit()) {
+			return false
+		}
+		return true
+	}
+	return false
+}
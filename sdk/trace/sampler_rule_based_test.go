@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// namedSampler samples all spans and records its name in the decision
+// attributes so tests can identify which sampler made the decision.
+type namedSampler string
+
+func (s namedSampler) ShouldSample(SamplingParameters) SamplingResult {
+	return SamplingResult{
+		Decision:   RecordAndSample,
+		Attributes: []attribute.KeyValue{attribute.String("sampler", string(s))},
+	}
+}
+
+func (s namedSampler) Description() string { return string(s) }
+
+func TestRuleBasedSampler(t *testing.T) {
+	sampler, err := RuleBased(RuleBasedSamplerConfig{
+		Rules: []SamplingRule{
+			{Name: "GET /health*", Kinds: []trace.SpanKind{trace.SpanKindServer}, Sampler: namedSampler("health")},
+			{NameRegexp: `^db\.(query|exec)$`, Sampler: namedSampler("db")},
+			{
+				Attributes: []AttributeMatcher{
+					{Key: "http.route", ValueRegexp: "^/admin/"},
+					{Key: "user.internal", Value: attribute.BoolValue(true)},
+				},
+				Sampler: namedSampler("admin"),
+			},
+			{Attributes: []AttributeMatcher{{Key: "debug"}}, Sampler: namedSampler("debug")},
+			{Parent: ParentRemote, Sampler: namedSampler("remote")},
+			{Parent: ParentNone, Kinds: []trace.SpanKind{trace.SpanKindInternal}, Sampler: namedSampler("internal-root")},
+		},
+		Default: namedSampler("default"),
+	})
+	require.NoError(t, err)
+
+	remoteCtx := trace.ContextWithRemoteSpanContext(context.Background(), sc)
+	localCtx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	tests := []struct {
+		name   string
+		params SamplingParameters
+		want   string
+	}{
+		{
+			name:   "glob and kind",
+			params: SamplingParameters{Name: "GET /healthz", Kind: trace.SpanKindServer},
+			want:   "health",
+		},
+		{
+			name:   "glob wrong kind",
+			params: SamplingParameters{Name: "GET /healthz", Kind: trace.SpanKindClient, ParentContext: localCtx},
+			want:   "default",
+		},
+		{
+			name:   "regexp",
+			params: SamplingParameters{Name: "db.exec", ParentContext: localCtx},
+			want:   "db",
+		},
+		{
+			name: "attributes",
+			params: SamplingParameters{
+				Name:          "GET",
+				ParentContext: localCtx,
+				Attributes: []attribute.KeyValue{
+					attribute.String("http.route", "/admin/users"),
+					attribute.Bool("user.internal", true),
+				},
+			},
+			want: "admin",
+		},
+		{
+			name: "partial attributes",
+			params: SamplingParameters{
+				Name:          "GET",
+				ParentContext: localCtx,
+				Attributes:    []attribute.KeyValue{attribute.String("http.route", "/admin/users")},
+			},
+			want: "default",
+		},
+		{
+			name: "attribute presence",
+			params: SamplingParameters{
+				ParentContext: localCtx,
+				Attributes:    []attribute.KeyValue{attribute.Int("debug", 1)},
+			},
+			want: "debug",
+		},
+		{
+			name:   "remote parent",
+			params: SamplingParameters{Name: "op", ParentContext: remoteCtx},
+			want:   "remote",
+		},
+		{
+			name:   "root",
+			params: SamplingParameters{Name: "op", Kind: trace.SpanKindUnspecified},
+			want:   "internal-root",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := sampler.ShouldSample(test.params)
+			assert.Equal(t, []attribute.KeyValue{attribute.String("sampler", test.want)}, res.Attributes)
+		})
+	}
+}
+
+func TestRuleBasedSamplerDescription(t *testing.T) {
+	sampler, err := RuleBased(RuleBasedSamplerConfig{
+		Rules: []SamplingRule{
+			{
+				Name:       "health*",
+				Kinds:      []trace.SpanKind{trace.SpanKindServer},
+				Attributes: []AttributeMatcher{{Key: "k", Value: attribute.StringValue("v"), ValueRegexp: "^v$"}},
+				Parent:     ParentNone,
+				Sampler:    NeverSample(),
+			},
+			{NameRegexp: "^db", Sampler: TraceIDRatioBased(0.5)},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t,
+		"RuleBased{rules:[{name:health*,kinds:[server],attribute:k=v~^v$,parent:none,sampler:AlwaysOffSampler},"+
+			"{nameRegexp:^db,sampler:TraceIDRatioBased{0.5}}],default:AlwaysOnSampler}",
+		sampler.Description(),
+	)
+}
+
+func TestRuleBasedSamplerInvalidConfig(t *testing.T) {
+	_, err := RuleBased(RuleBasedSamplerConfig{
+		Rules: []SamplingRule{
+			{Name: "no sampler"},
+			{Attributes: []AttributeMatcher{{Value: attribute.StringValue("v")}}, Sampler: AlwaysSample()},
+			{NameRegexp: "(", Sampler: AlwaysSample()},
+			{Attributes: []AttributeMatcher{{Key: "k", ValueRegexp: "["}}, Sampler: AlwaysSample()},
+		},
+	})
+	assert.ErrorIs(t, err, errNoRuleSampler)
+	assert.ErrorIs(t, err, errNoMatcherKey)
+	assert.ErrorContains(t, err, "sampling rule 2")
+	assert.ErrorContains(t, err, "sampling rule 3")
+}
+
+func TestRuleBasedSamplerWithTracerProvider(t *testing.T) {
+	sampler, err := RuleBased(RuleBasedSamplerConfig{
+		Rules: []SamplingRule{
+			{Name: "/readyz", Sampler: NeverSample()},
+		},
+		Default: ParentBased(AlwaysSample()),
+	})
+	require.NoError(t, err)
+
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithSampler(sampler))
+	tr := tp.Tracer("TestRuleBasedSamplerWithTracerProvider")
+	_, span := tr.Start(context.Background(), "/readyz")
+	span.End()
+	_, span = tr.Start(context.Background(), "/api")
+	span.End()
+
+	require.Equal(t, 1, te.Len())
+	assert.Equal(t, "/api", te.Spans()[0].Name())
+}
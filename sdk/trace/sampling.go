@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -108,6 +110,155 @@ func TraceIDRatioBased(fraction float64) Sampler {
 	}
 }
 
+// samplingProbabilityKey is the attribute key used by the RateLimited
+// sampler to record the effective sampling probability of a sampled span.
+const samplingProbabilityKey = attribute.Key("sampling.probability")
+
+// rateLimitWindow is the period over which the effective sampling
+// probability of a RateLimited sampler is measured.
+const rateLimitWindow = time.Second
+
+// rateLimiter is a token bucket that is refilled at a constant rate up to a
+// maximum balance.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	creditsPerSecond float64
+	maxBalance       float64
+	balance          float64
+	lastTick         time.Time
+
+	// now returns the current time. It is a field so tests can override it.
+	now func() time.Time
+}
+
+// newRateLimiter returns a full rateLimiter that is refilled with
+// creditsPerSecond credits every second, up to maxBalance credits.
+func newRateLimiter(creditsPerSecond, maxBalance float64) *rateLimiter {
+	return &rateLimiter{
+		creditsPerSecond: creditsPerSecond,
+		maxBalance:       maxBalance,
+		balance:          maxBalance,
+		lastTick:         time.Now(),
+		now:              time.Now,
+	}
+}
+
+// allow reports whether cost credits are available, and if so, withdraws
+// them from the balance.
+func (r *rateLimiter) allow(cost float64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if elapsed := now.Sub(r.lastTick); elapsed > 0 {
+		r.balance += elapsed.Seconds() * r.creditsPerSecond
+		if r.balance > r.maxBalance {
+			r.balance = r.maxBalance
+		}
+		r.lastTick = now
+	}
+
+	if r.balance >= cost {
+		r.balance -= cost
+		return true
+	}
+	return false
+}
+
+type rateLimitedSampler struct {
+	limiter     *rateLimiter
+	description string
+
+	// mu protects the fields below used to measure the effective sampling
+	// probability.
+	mu          sync.Mutex
+	windowStart time.Time
+	seen        uint64
+	sampled     uint64
+	// probability is the effective sampling probability of the last
+	// completed window, 1 until a window has completed.
+	probability float64
+}
+
+func (rs *rateLimitedSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	psc := trace.SpanContextFromContext(p.ParentContext)
+	sampled := rs.limiter.allow(1)
+	probability := rs.record(sampled)
+	if sampled {
+		return SamplingResult{
+			Decision:   RecordAndSample,
+			Attributes: []attribute.KeyValue{samplingProbabilityKey.Float64(probability)},
+			Tracestate: psc.TraceState(),
+		}
+	}
+	return SamplingResult{
+		Decision:   Drop,
+		Tracestate: psc.TraceState(),
+	}
+}
+
+// record accounts for a sampling decision and returns the effective sampling
+// probability measured over the last completed window.
+//
+// The current window is not used: until the burst is exhausted, its ratio is
+// 1 no matter how many spans are dropped later in the window.
+func (rs *rateLimitedSampler) record(sampled bool) float64 {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	now := rs.limiter.now()
+	if elapsed := now.Sub(rs.windowStart); elapsed >= rateLimitWindow {
+		switch {
+		case elapsed >= 2*rateLimitWindow || rs.seen == 0:
+			// The last completed window had no spans, all were sampled.
+			rs.probability = 1
+		default:
+			rs.probability = float64(rs.sampled) / float64(rs.seen)
+		}
+		rs.windowStart = now
+		rs.seen, rs.sampled = 0, 0
+	}
+
+	rs.seen++
+	if sampled {
+		rs.sampled++
+	}
+	return rs.probability
+}
+
+func (rs *rateLimitedSampler) Description() string {
+	return rs.description
+}
+
+// RateLimited samples at most tracesPerSecond traces per second. The sampler
+// is a token bucket that holds at most burst tokens. A value of burst less
+// than 1 is replaced with the greater of tracesPerSecond and 1. A negative
+// tracesPerSecond is treated as zero.
+//
+// Each sampled span is given a "sampling.probability" attribute with the
+// effective sampling probability measured over the last completed one second
+// window, or 1 before the first window has completed. Back-ends can use this value to extrapolate the number
+// of spans that were created. To respect the parent trace's `SampledFlag`,
+// the RateLimited sampler should be used as a delegate of a `Parent` sampler.
+func RateLimited(tracesPerSecond float64, burst int) Sampler {
+	if tracesPerSecond < 0 {
+		tracesPerSecond = 0
+	}
+	maxBalance := float64(burst)
+	if burst < 1 {
+		maxBalance = max(tracesPerSecond, 1)
+	}
+
+	limiter := newRateLimiter(tracesPerSecond, maxBalance)
+	return &rateLimitedSampler{
+		limiter:     limiter,
+		description: fmt.Sprintf("RateLimited{%g,burst:%g}", tracesPerSecond, maxBalance),
+		windowStart: limiter.lastTick,
+		probability: 1,
+	}
+}
+
 type alwaysOnSampler struct{}
 
 func (as alwaysOnSampler) ShouldSample(p SamplingParameters) SamplingResult {
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -246,3 +248,89 @@ func TestTracestateIsPassed(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	rl := newRateLimiter(2, 2)
+	rl.now = func() time.Time { return now }
+	rl.lastTick = now
+
+	assert.True(t, rl.allow(1))
+	assert.True(t, rl.allow(1))
+	assert.False(t, rl.allow(1), "bucket should be empty")
+
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, rl.allow(1), "bucket should be refilled by one")
+	assert.False(t, rl.allow(1))
+
+	now = now.Add(time.Hour)
+	assert.True(t, rl.allow(1))
+	assert.True(t, rl.allow(1))
+	assert.False(t, rl.allow(1), "bucket should not exceed max balance")
+}
+
+func TestRateLimited(t *testing.T) {
+	now := time.Now()
+	s := RateLimited(2, 4).(*rateLimitedSampler)
+	s.limiter.now = func() time.Time { return now }
+	s.limiter.lastTick = now
+	s.windowStart = now
+
+	params := SamplingParameters{TraceID: tid}
+	probability := func(res SamplingResult) []attribute.KeyValue {
+		t.Helper()
+		require.Equal(t, RecordAndSample, res.Decision)
+		return res.Attributes
+	}
+
+	// No window has completed yet, the probability is 1.
+	var sampled int
+	for i := 0; i < 100; i++ {
+		res := s.ShouldSample(params)
+		if res.Decision == RecordAndSample {
+			sampled++
+			assert.Equal(t, []attribute.KeyValue{samplingProbabilityKey.Float64(1)}, res.Attributes)
+		}
+	}
+	assert.Equal(t, 4, sampled, "burst should be admitted")
+
+	// Refill 1 token within the same measurement window.
+	now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, []attribute.KeyValue{samplingProbabilityKey.Float64(1)}, probability(s.ShouldSample(params)))
+	assert.Equal(t, Drop, s.ShouldSample(params).Decision)
+
+	// Refill 2 tokens and start a new measurement window: the first spans
+	// of the window report the ratio of the previous window, 5 of 102.
+	now = now.Add(time.Second)
+	want := []attribute.KeyValue{samplingProbabilityKey.Float64(5.0 / 102.0)}
+	assert.Equal(t, want, probability(s.ShouldSample(params)))
+	assert.Equal(t, want, probability(s.ShouldSample(params)))
+	assert.Equal(t, Drop, s.ShouldSample(params).Decision)
+	assert.Equal(t, Drop, s.ShouldSample(params).Decision)
+
+	// 2 of 4 spans were sampled in the previous window.
+	now = now.Add(time.Second)
+	assert.Equal(t, []attribute.KeyValue{samplingProbabilityKey.Float64(0.5)}, probability(s.ShouldSample(params)))
+
+	// The previous window had no spans.
+	now = now.Add(3 * time.Second)
+	assert.Equal(t, []attribute.KeyValue{samplingProbabilityKey.Float64(1)}, probability(s.ShouldSample(params)))
+}
+
+func TestRateLimitedDescription(t *testing.T) {
+	assert.Equal(t, "RateLimited{10,burst:10}", RateLimited(10, 0).Description())
+	assert.Equal(t, "RateLimited{0.5,burst:1}", RateLimited(0.5, 0).Description())
+	assert.Equal(t, "RateLimited{10,burst:20}", RateLimited(10, 20).Description())
+	assert.Equal(t, "RateLimited{0,burst:1}", RateLimited(-1, 0).Description())
+}
+
+func TestRateLimitedParentBased(t *testing.T) {
+	sampler := ParentBased(RateLimited(0, 1))
+	params := SamplingParameters{TraceID: tid}
+	assert.Equal(t, RecordAndSample, sampler.ShouldSample(params).Decision)
+	assert.Equal(t, Drop, sampler.ShouldSample(params).Decision)
+
+	// A sampled parent is always respected.
+	params.ParentContext = trace.ContextWithSpanContext(context.Background(), sc)
+	assert.Equal(t, RecordAndSample, sampler.ShouldSample(params).Decision)
+}
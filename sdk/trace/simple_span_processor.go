@@ -6,9 +6,11 @@ package trace // import "go.opentelemetry.io/otel/sdk/trace"
 import (
 	"context"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/metric"
 )
 
 // simpleSpanProcessor is a SpanProcessor that synchronously sends all
@@ -17,6 +19,7 @@ type simpleSpanProcessor struct {
 	exporterMu sync.Mutex
 	exporter   SpanExporter
 	stopOnce   sync.Once
+	metrics    *spanProcessorMetrics
 }
 
 var _ SpanProcessor = (*simpleSpanProcessor)(nil)
@@ -29,15 +32,48 @@ var _ SpanProcessor = (*simpleSpanProcessor)(nil)
 // examples of other features, but it will be slow and have a high computation
 // resource usage overhead. The BatchSpanProcessor is recommended for production
 // use instead.
-func NewSimpleSpanProcessor(exporter SpanExporter) SpanProcessor {
+func NewSimpleSpanProcessor(exporter SpanExporter, opts ...SimpleSpanProcessorOption) SpanProcessor {
+	var cfg simpleSpanProcessorConfig
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
 	ssp := &simpleSpanProcessor{
 		exporter: exporter,
+		metrics:  newSpanProcessorMetrics(cfg.meterProvider, simpleSpanProcessorComponentType, nil),
 	}
 	global.Warn("SimpleSpanProcessor is not recommended for production use, consider using BatchSpanProcessor instead.")
 
 	return ssp
 }
 
+// simpleSpanProcessorConfig is a group of options for a simpleSpanProcessor.
+type simpleSpanProcessorConfig struct {
+	meterProvider metric.MeterProvider
+}
+
+// SimpleSpanProcessorOption configures a SimpleSpanProcessor.
+type SimpleSpanProcessorOption interface {
+	apply(simpleSpanProcessorConfig) simpleSpanProcessorConfig
+}
+
+type simpleSpanProcessorOptionFunc func(simpleSpanProcessorConfig) simpleSpanProcessorConfig
+
+func (fn simpleSpanProcessorOptionFunc) apply(cfg simpleSpanProcessorConfig) simpleSpanProcessorConfig {
+	return fn(cfg)
+}
+
+// WithSimpleSpanProcessorMeterProvider returns a SimpleSpanProcessorOption
+// that configures the MeterProvider a SimpleSpanProcessor uses to report its
+// self-observability metrics: the number of spans processed and that failed
+// to be exported, and the duration of exports. If not set, no metrics are
+// reported.
+func WithSimpleSpanProcessorMeterProvider(mp metric.MeterProvider) SimpleSpanProcessorOption {
+	return simpleSpanProcessorOptionFunc(func(cfg simpleSpanProcessorConfig) simpleSpanProcessorConfig {
+		cfg.meterProvider = mp
+		return cfg
+	})
+}
+
 // OnStart does nothing.
 func (ssp *simpleSpanProcessor) OnStart(context.Context, ReadWriteSpan) {}
 
@@ -47,7 +83,10 @@ func (ssp *simpleSpanProcessor) OnEnd(s ReadOnlySpan) {
 	defer ssp.exporterMu.Unlock()
 
 	if ssp.exporter != nil && s.SpanContext().TraceFlags().IsSampled() {
-		if err := ssp.exporter.ExportSpans(context.Background(), []ReadOnlySpan{s}); err != nil {
+		start := time.Now()
+		err := ssp.exporter.ExportSpans(context.Background(), []ReadOnlySpan{s})
+		ssp.metrics.exported(1, start, err)
+		if err != nil {
 			otel.Handle(err)
 		}
 	}
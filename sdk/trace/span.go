@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"runtime"
+	"runtime/pprof"
 	rt "runtime/trace"
 	"slices"
 	"strings"
@@ -148,6 +149,11 @@ type recordingSpan struct {
 	// executionTracerTaskEnd ends the execution tracer span.
 	executionTracerTaskEnd func()
 
+	// profilerLabelsParent is the context the span was started with, whose
+	// pprof labels are set back on the goroutine when the span ends. It is
+	// nil unless profiler labels were set for the span.
+	profilerLabelsParent context.Context
+
 	// tracer is the SDK tracer that created this span.
 	tracer *tracer
 }
@@ -217,7 +223,7 @@ func (s *recordingSpan) SetAttributes(attributes ...attribute.KeyValue) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	limit := s.tracer.provider.spanLimits.AttributeCountLimit
+	limit := s.tracer.spanLimits().AttributeCountLimit
 	if limit == 0 {
 		// No attributes allowed.
 		s.addDroppedAttr(len(attributes))
@@ -240,7 +246,7 @@ func (s *recordingSpan) SetAttributes(attributes ...attribute.KeyValue) {
 			s.addDroppedAttr(1)
 			continue
 		}
-		a = truncateAttr(s.tracer.provider.spanLimits.AttributeValueLengthLimit, a)
+		a = truncateAttr(s.tracer.spanLimits().AttributeValueLengthLimit, a)
 		s.attributes = append(s.attributes, a)
 	}
 }
@@ -305,7 +311,7 @@ func (s *recordingSpan) addOverCapAttrs(limit int, attrs []attribute.KeyValue) {
 			// updates are checked and performed.
 			s.addDroppedAttr(1)
 		} else {
-			a = truncateAttr(s.tracer.provider.spanLimits.AttributeValueLengthLimit, a)
+			a = truncateAttr(s.tracer.spanLimits().AttributeValueLengthLimit, a)
 			s.attributes = append(s.attributes, a)
 			exists[a.Key] = len(s.attributes) - 1
 		}
@@ -416,6 +422,13 @@ func (s *recordingSpan) End(options ...trace.SpanEndOption) {
 		s.executionTracerTaskEnd()
 	}
 
+	s.mu.Lock()
+	parent := s.profilerLabelsParent
+	s.mu.Unlock()
+	if parent != nil {
+		pprof.SetGoroutineLabels(parent)
+	}
+
 	s.mu.Lock()
 	// Setting endTime to non-zero marks the span as ended and not recording.
 	if config.Timestamp().IsZero() {
@@ -499,7 +512,7 @@ func (s *recordingSpan) addEvent(name string, o ...trace.EventOption) {
 	e := Event{Name: name, Attributes: c.Attributes(), Time: c.Timestamp()}
 
 	// Discard attributes over limit.
-	limit := s.tracer.provider.spanLimits.AttributePerEventCountLimit
+	limit := s.tracer.spanLimits().AttributePerEventCountLimit
 	if limit == 0 {
 		// Drop all attributes.
 		e.DroppedAttributeCount = len(e.Attributes)
@@ -668,7 +681,7 @@ func (s *recordingSpan) AddLink(link trace.Link) {
 	l := Link{SpanContext: link.SpanContext, Attributes: link.Attributes}
 
 	// Discard attributes over limit.
-	limit := s.tracer.provider.spanLimits.AttributePerLinkCountLimit
+	limit := s.tracer.spanLimits().AttributePerLinkCountLimit
 	if limit == 0 {
 		// Drop all attributes.
 		l.DroppedAttributeCount = len(l.Attributes)
@@ -781,6 +794,38 @@ func (s *recordingSpan) runtimeTrace(ctx context.Context) context.Context {
 	return nctx
 }
 
+// pprof labels set by profilerLabels.
+const (
+	profilerSpanIDLabel          = "span_id"
+	profilerLocalRootSpanIDLabel = "local_root_span_id"
+	profilerSpanNameLabel        = "span_name"
+)
+
+// profilerLabels adds the pprof labels of the span to ctx, sets them on the
+// current goroutine, and returns the new context. The labels of ctx are set
+// back on the goroutine when the span ends.
+func (s *recordingSpan) profilerLabels(ctx context.Context) context.Context {
+	sid := s.spanContext.SpanID().String()
+	root := sid
+	if s.parent.IsValid() && !s.parent.IsRemote() {
+		if r, ok := pprof.Label(ctx, profilerLocalRootSpanIDLabel); ok {
+			root = r
+		}
+	}
+	nctx := pprof.WithLabels(ctx, pprof.Labels(
+		profilerSpanIDLabel, sid,
+		profilerLocalRootSpanIDLabel, root,
+		profilerSpanNameLabel, s.name,
+	))
+	pprof.SetGoroutineLabels(nctx)
+
+	s.mu.Lock()
+	s.profilerLabelsParent = ctx
+	s.mu.Unlock()
+
+	return nctx
+}
+
 // nonRecordingSpan is a minimal implementation of the OpenTelemetry Span API
 // that wraps a SpanContext. It performs no operations other than to return
 // the wrapped SpanContext or TracerProvider that created it.
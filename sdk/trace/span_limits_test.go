@@ -127,7 +127,7 @@ func TestSettingSpanLimits(t *testing.T) {
 				opts = append(opts, WithRawSpanLimits(*test.rawOpt))
 			}
 
-			assert.Equal(t, test.want, NewTracerProvider(opts...).spanLimits)
+			assert.Equal(t, test.want, *NewTracerProvider(opts...).getSpanLimits())
 		})
 	}
 }
@@ -0,0 +1,240 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/sdk"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Defaults for SpanMetricsOptions.
+const (
+	DefaultSpanMetricsMaxCardinality = 1000
+	DefaultSpanMetricsScopeName      = "go.opentelemetry.io/otel/sdk/trace/spanmetrics"
+)
+
+// Attribute keys of the metrics recorded by a SpanMetricsProcessor.
+const (
+	spanMetricsNameKey       = attribute.Key("span.name")
+	spanMetricsKindKey       = attribute.Key("span.kind")
+	spanMetricsStatusCodeKey = attribute.Key("status.code")
+	spanMetricsOverflowKey   = attribute.Key("otel.metric.overflow")
+)
+
+// SpanMetricsOption configures a SpanMetricsProcessor.
+type SpanMetricsOption func(o *SpanMetricsOptions)
+
+// SpanMetricsOptions is configuration settings for a SpanMetricsProcessor.
+type SpanMetricsOptions struct {
+	// Dimensions are the keys of the span attributes added to the attributes
+	// of the recorded metrics, in addition to the span name, kind, and
+	// status code. Spans without an attribute are recorded without it.
+	Dimensions []attribute.Key
+
+	// MaxCardinality is the maximum number of distinct attribute sets
+	// recorded. Spans that would be recorded with a new attribute set once
+	// the limit is reached are recorded with the otel.metric.overflow=true
+	// attribute instead. The attribute sets are never forgotten, as the
+	// MeterProvider keeps the series of cumulative metrics for its lifetime.
+	// The default value of MaxCardinality is 1000.
+	MaxCardinality int
+
+	// ScopeName is the name of the instrumentation scope of the Meter used
+	// to record the metrics.
+	// The default value of ScopeName is
+	// "go.opentelemetry.io/otel/sdk/trace/spanmetrics".
+	ScopeName string
+
+	// DurationBoundaries are the explicit bucket boundaries, in seconds, of
+	// the duration histogram. If empty, the default boundaries of the
+	// MeterProvider are used.
+	DurationBoundaries []float64
+}
+
+// WithSpanMetricsDimensions returns a SpanMetricsOption that configures the
+// keys of the span attributes added to the attributes of the recorded
+// metrics.
+func WithSpanMetricsDimensions(keys ...attribute.Key) SpanMetricsOption {
+	return func(o *SpanMetricsOptions) {
+		o.Dimensions = append(o.Dimensions, keys...)
+	}
+}
+
+// WithSpanMetricsMaxCardinality returns a SpanMetricsOption that configures
+// the maximum number of distinct attribute sets recorded.
+func WithSpanMetricsMaxCardinality(limit int) SpanMetricsOption {
+	return func(o *SpanMetricsOptions) {
+		o.MaxCardinality = limit
+	}
+}
+
+// WithSpanMetricsScopeName returns a SpanMetricsOption that configures the
+// name of the instrumentation scope of the Meter used to record the
+// metrics.
+func WithSpanMetricsScopeName(name string) SpanMetricsOption {
+	return func(o *SpanMetricsOptions) {
+		o.ScopeName = name
+	}
+}
+
+// WithSpanMetricsDurationBoundaries returns a SpanMetricsOption that
+// configures the explicit bucket boundaries, in seconds, of the duration
+// histogram.
+func WithSpanMetricsDurationBoundaries(bounds ...float64) SpanMetricsOption {
+	return func(o *SpanMetricsOptions) {
+		o.DurationBoundaries = bounds
+	}
+}
+
+// SpanMetricsProcessor is a SpanProcessor that records request rate, error,
+// and duration (RED) metrics from the ended spans.
+//
+// The following instruments are used:
+//   - traces.span.metrics.calls: the number of ended spans.
+//   - traces.span.metrics.duration: the duration of the ended spans, in
+//     seconds.
+//
+// Both are recorded by a Meter with the configured instrumentation scope
+// name, with the span.name, span.kind, and status.code attributes and the
+// configured dimensions. Only spans that are recording
+// are passed to SpanProcessors, use a Sampler returning RecordOnly for the
+// spans that are not sampled to have them accounted for.
+type SpanMetricsProcessor struct {
+	calls    metric.Int64Counter
+	duration metric.Float64Histogram
+
+	o SpanMetricsOptions
+
+	// mu guards seen.
+	mu       sync.Mutex
+	seen     map[attribute.Distinct]struct{}
+	overflow metric.MeasurementOption
+}
+
+var _ SpanProcessor = (*SpanMetricsProcessor)(nil)
+
+// NewSpanMetricsProcessor returns a SpanMetricsProcessor that records
+// metrics with mp. If mp is nil, no metrics are recorded.
+func NewSpanMetricsProcessor(mp metric.MeterProvider, options ...SpanMetricsOption) *SpanMetricsProcessor {
+	o := SpanMetricsOptions{
+		MaxCardinality: DefaultSpanMetricsMaxCardinality,
+		ScopeName:      DefaultSpanMetricsScopeName,
+	}
+	for _, opt := range options {
+		opt(&o)
+	}
+	if o.MaxCardinality <= 0 {
+		o.MaxCardinality = DefaultSpanMetricsMaxCardinality
+	}
+	if o.ScopeName == "" {
+		o.ScopeName = DefaultSpanMetricsScopeName
+	}
+	if mp == nil {
+		mp = noop.NewMeterProvider()
+	}
+
+	smp := &SpanMetricsProcessor{
+		o:        o,
+		seen:     make(map[attribute.Distinct]struct{}),
+		overflow: metric.WithAttributes(spanMetricsOverflowKey.Bool(true)),
+	}
+	m := mp.Meter(o.ScopeName, metric.WithInstrumentationVersion(sdk.Version()))
+
+	var err, e error
+	smp.calls, e = m.Int64Counter(
+		"traces.span.metrics.calls",
+		metric.WithUnit("{call}"),
+		metric.WithDescription("The number of ended spans."),
+	)
+	err = errors.Join(err, e)
+	histOpts := []metric.Float64HistogramOption{
+		metric.WithUnit("s"),
+		metric.WithDescription("The duration of ended spans."),
+	}
+	if len(o.DurationBoundaries) > 0 {
+		histOpts = append(histOpts, metric.WithExplicitBucketBoundaries(o.DurationBoundaries...))
+	}
+	smp.duration, e = m.Float64Histogram("traces.span.metrics.duration", histOpts...)
+	err = errors.Join(err, e)
+	if err != nil {
+		otel.Handle(err)
+	}
+	if smp.calls == nil {
+		smp.calls = noop.Int64Counter{}
+	}
+	if smp.duration == nil {
+		smp.duration = noop.Float64Histogram{}
+	}
+	return smp
+}
+
+// OnStart does nothing.
+func (smp *SpanMetricsProcessor) OnStart(context.Context, ReadWriteSpan) {}
+
+// OnEnd records the metrics of s.
+func (smp *SpanMetricsProcessor) OnEnd(s ReadOnlySpan) {
+	opt := smp.attributes(s)
+	ctx := context.Background()
+	smp.calls.Add(ctx, 1, opt)
+	smp.duration.Record(ctx, s.EndTime().Sub(s.StartTime()).Seconds(), opt)
+}
+
+// attributes returns the measurement attributes of s, or the overflow
+// attributes if the cardinality limit is reached.
+func (smp *SpanMetricsProcessor) attributes(s ReadOnlySpan) metric.MeasurementOption {
+	kvs := make([]attribute.KeyValue, 3, 3+len(smp.o.Dimensions))
+	kvs[0] = spanMetricsNameKey.String(s.Name())
+	kvs[1] = spanMetricsKindKey.String(spanKindName(s.SpanKind()))
+	kvs[2] = spanMetricsStatusCodeKey.String(statusCodeName(s.Status().Code))
+	if len(smp.o.Dimensions) > 0 {
+		attrs := s.Attributes()
+		for _, k := range smp.o.Dimensions {
+			// The last value of duplicate keys is the one in effect.
+			for i := len(attrs) - 1; i >= 0; i-- {
+				if attrs[i].Key == k {
+					kvs = append(kvs, attrs[i])
+					break
+				}
+			}
+		}
+	}
+	set := attribute.NewSet(kvs...)
+
+	smp.mu.Lock()
+	defer smp.mu.Unlock()
+	if _, ok := smp.seen[set.Equivalent()]; !ok {
+		if len(smp.seen) >= smp.o.MaxCardinality {
+			return smp.overflow
+		}
+		smp.seen[set.Equivalent()] = struct{}{}
+	}
+	return metric.WithAttributeSet(set)
+}
+
+// spanKindName returns the OTLP name of kind, e.g. SPAN_KIND_SERVER.
+func spanKindName(kind trace.SpanKind) string {
+	kind = trace.ValidateSpanKind(kind)
+	return "SPAN_KIND_" + strings.ToUpper(kind.String())
+}
+
+// statusCodeName returns the OTLP name of code, e.g. STATUS_CODE_ERROR.
+func statusCodeName(code codes.Code) string {
+	return "STATUS_CODE_" + strings.ToUpper(code.String())
+}
+
+// Shutdown does nothing, the metrics are exported by the MeterProvider.
+func (smp *SpanMetricsProcessor) Shutdown(context.Context) error { return nil }
+
+// ForceFlush does nothing, the metrics are exported by the MeterProvider.
+func (smp *SpanMetricsProcessor) ForceFlush(context.Context) error { return nil }
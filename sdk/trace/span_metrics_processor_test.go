@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSpanMetricsProcessor(t *testing.T) {
+	mp := newRecordingMeterProvider()
+	smp := NewSpanMetricsProcessor(mp, WithSpanMetricsDimensions("http.route", "missing"))
+	tp := NewTracerProvider(WithSpanProcessor(smp))
+	tr := tp.Tracer("TestSpanMetricsProcessor")
+
+	for i := 0; i < 3; i++ {
+		_, span := tr.Start(context.Background(), "GET", trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("http.route", "/users"), attribute.Int("ignored", i)))
+		span.End()
+	}
+	_, span := tr.Start(context.Background(), "query", trace.WithSpanKind(trace.SpanKindClient))
+	span.SetStatus(codes.Error, "failed")
+	span.End()
+	require.NoError(t, tp.Shutdown(context.Background()))
+
+	server := []attribute.KeyValue{
+		attribute.String("span.name", "GET"),
+		attribute.String("span.kind", "SPAN_KIND_SERVER"),
+		attribute.String("status.code", "STATUS_CODE_UNSET"),
+		attribute.String("http.route", "/users"),
+	}
+	assert.Equal(t, int64(3), mp.count(server...))
+	assert.Equal(t, 3, mp.exports(server...))
+
+	failed := []attribute.KeyValue{
+		attribute.String("span.name", "query"),
+		attribute.String("span.kind", "SPAN_KIND_CLIENT"),
+		attribute.String("status.code", "STATUS_CODE_ERROR"),
+	}
+	assert.Equal(t, int64(1), mp.count(failed...))
+	assert.Equal(t, 1, mp.exports(failed...))
+}
+
+func TestSpanMetricsProcessorMaxCardinality(t *testing.T) {
+	mp := newRecordingMeterProvider()
+	smp := NewSpanMetricsProcessor(mp, WithSpanMetricsMaxCardinality(2))
+	tp := NewTracerProvider(WithSpanProcessor(smp))
+	tr := tp.Tracer("TestSpanMetricsProcessorMaxCardinality")
+
+	for _, name := range []string{"a", "b", "c", "d", "a"} {
+		_, span := tr.Start(context.Background(), name)
+		span.End()
+	}
+
+	attrs := func(name string) []attribute.KeyValue {
+		return []attribute.KeyValue{
+			attribute.String("span.name", name),
+			attribute.String("span.kind", "SPAN_KIND_INTERNAL"),
+			attribute.String("status.code", "STATUS_CODE_UNSET"),
+		}
+	}
+	assert.Equal(t, int64(2), mp.count(attrs("a")...))
+	assert.Equal(t, int64(1), mp.count(attrs("b")...))
+	assert.Zero(t, mp.count(attrs("c")...))
+	assert.Equal(t, int64(2), mp.count(attribute.Bool("otel.metric.overflow", true)))
+}
+
+func TestSpanMetricsProcessorScopeName(t *testing.T) {
+	mp := newRecordingMeterProvider()
+	NewSpanMetricsProcessor(mp)
+	assert.Equal(t, DefaultSpanMetricsScopeName, mp.scope)
+
+	NewSpanMetricsProcessor(mp, WithSpanMetricsScopeName("custom"))
+	assert.Equal(t, "custom", mp.scope)
+}
+
+func TestSpanMetricsProcessorNilMeterProvider(t *testing.T) {
+	tp := NewTracerProvider(WithSpanProcessor(NewSpanMetricsProcessor(nil)))
+	_, span := tp.Tracer("TestSpanMetricsProcessorNilMeterProvider").Start(context.Background(), "span")
+	assert.NotPanics(t, func() { span.End() })
+	assert.NoError(t, tp.Shutdown(context.Background()))
+}
+
+func TestSpanKindAndStatusCodeNames(t *testing.T) {
+	assert.Equal(t, "SPAN_KIND_INTERNAL", spanKindName(trace.SpanKindUnspecified))
+	assert.Equal(t, "SPAN_KIND_CONSUMER", spanKindName(trace.SpanKindConsumer))
+	assert.Equal(t, "STATUS_CODE_OK", statusCodeName(codes.Ok))
+}
@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/sdk"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+)
+
+const (
+	// selfObservabilityScope is the name of the instrumentation scope used
+	// to report the self-observability metrics of the SDK.
+	selfObservabilityScope = "go.opentelemetry.io/otel/sdk/trace"
+
+	componentTypeKey = attribute.Key("otel.component.type")
+	componentNameKey = attribute.Key("otel.component.name")
+	spanPriorityKey  = attribute.Key("otel.sdk.span.priority")
+
+	// errorTypeQueueFull is the error.type of spans dropped because the
+	// queue of a span processor is full.
+	errorTypeQueueFull = "queue_full"
+	// errorTypeTimeout is the error.type of spans whose export timed out.
+	errorTypeTimeout = "timeout"
+	// errorTypeCanceled is the error.type of spans whose export was
+	// canceled.
+	errorTypeCanceled = "canceled"
+	// errorTypeOther is the error.type of spans whose export failed with
+	// any other error.
+	errorTypeOther = "_OTHER"
+
+	batchSpanProcessorComponentType  = "batching_span_processor"
+	simpleSpanProcessorComponentType = "simple_span_processor"
+)
+
+var (
+	componentIDsMu sync.Mutex
+	componentIDs   = make(map[string]int)
+)
+
+// nextComponentName returns a name for a new component of componentType that
+// is unique within the process.
+func nextComponentName(componentType string) string {
+	componentIDsMu.Lock()
+	defer componentIDsMu.Unlock()
+	id := componentIDs[componentType]
+	componentIDs[componentType] = id + 1
+	return fmt.Sprintf("%s/%d", componentType, id)
+}
+
+// spanProcessorMetrics reports the self-observability metrics of a span
+// processor.
+//
+// The following instruments are used:
+//   - otel.sdk.processor.span.processed: the number of spans processed. Spans
+//     dropped or that failed to be exported have the error.type attribute.
+//     Spans dropped by a priority-aware queue also have the
+//     otel.sdk.span.priority attribute.
+//   - otel.sdk.processor.span.export.duration: the duration of each export.
+//     Failed exports have the error.type attribute.
+//   - otel.sdk.processor.span.queue.size: the number of spans in the queue
+//     (only for processors with a queue).
+//   - otel.sdk.processor.span.queue.capacity: the maximum number of spans the
+//     queue can hold (only for processors with a queue).
+type spanProcessorMetrics struct {
+	processed      metric.Int64Counter
+	exportDuration metric.Float64Histogram
+	registration   metric.Registration
+
+	attrs     attribute.Set
+	okOpt     metric.MeasurementOption
+	queueFull metric.MeasurementOption
+}
+
+// newSpanProcessorMetrics returns a spanProcessorMetrics reporting metrics
+// for a span processor of componentType with mp. If queue is not nil, it is
+// called to observe the current size and capacity of the processor queue.
+//
+// If mp is nil, a no-op MeterProvider is used.
+func newSpanProcessorMetrics(mp metric.MeterProvider, componentType string, queue func() (size, capacity int)) *spanProcessorMetrics {
+	if mp == nil {
+		mp = noop.NewMeterProvider()
+	}
+	m := mp.Meter(selfObservabilityScope, metric.WithInstrumentationVersion(sdk.Version()))
+
+	attrs := attribute.NewSet(
+		componentTypeKey.String(componentType),
+		componentNameKey.String(nextComponentName(componentType)),
+	)
+	spm := &spanProcessorMetrics{
+		attrs: attrs,
+		okOpt: metric.WithAttributeSet(attrs),
+		queueFull: metric.WithAttributeSet(attribute.NewSet(
+			append(attrs.ToSlice(), semconv.ErrorTypeKey.String(errorTypeQueueFull))...,
+		)),
+	}
+
+	var err, e error
+	spm.processed, e = m.Int64Counter(
+		"otel.sdk.processor.span.processed",
+		metric.WithUnit("{span}"),
+		metric.WithDescription("The number of spans processed by the span processor."),
+	)
+	err = errors.Join(err, e)
+	spm.exportDuration, e = m.Float64Histogram(
+		"otel.sdk.processor.span.export.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("The duration of span exports made by the span processor."),
+	)
+	err = errors.Join(err, e)
+
+	if queue != nil {
+		size, e := m.Int64ObservableUpDownCounter(
+			"otel.sdk.processor.span.queue.size",
+			metric.WithUnit("{span}"),
+			metric.WithDescription("The number of spans in the queue of the span processor."),
+		)
+		err = errors.Join(err, e)
+		capacity, e := m.Int64ObservableUpDownCounter(
+			"otel.sdk.processor.span.queue.capacity",
+			metric.WithUnit("{span}"),
+			metric.WithDescription("The maximum number of spans the queue of the span processor can hold."),
+		)
+		err = errors.Join(err, e)
+
+		spm.registration, e = m.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+			s, c := queue()
+			o.ObserveInt64(size, int64(s), spm.okOpt)
+			o.ObserveInt64(capacity, int64(c), spm.okOpt)
+			return nil
+		}, size, capacity)
+		err = errors.Join(err, e)
+	}
+
+	if err != nil {
+		otel.Handle(err)
+	}
+	// Instruments returned with an error are still valid no-op or partially
+	// functional instruments, use them regardless.
+	if spm.processed == nil {
+		spm.processed = noop.Int64Counter{}
+	}
+	if spm.exportDuration == nil {
+		spm.exportDuration = noop.Float64Histogram{}
+	}
+	return spm
+}
+
+// dropped records n spans dropped because the queue was full.
+func (spm *spanProcessorMetrics) dropped(n int64) {
+	spm.processed.Add(context.Background(), n, spm.queueFull)
+}
+
+// droppedPriority records n spans of priority p dropped because the queue was
+// full.
+func (spm *spanProcessorMetrics) droppedPriority(n int64, p SpanPriority) {
+	spm.processed.Add(context.Background(), n, metric.WithAttributeSet(attribute.NewSet(
+		append(spm.attrs.ToSlice(),
+			semconv.ErrorTypeKey.String(errorTypeQueueFull),
+			spanPriorityKey.String(p.String()),
+		)...,
+	)))
+}
+
+// exported records the export of n spans that started at start and ended
+// with err.
+func (spm *spanProcessorMetrics) exported(n int64, start time.Time, err error) {
+	opt := spm.okOpt
+	if err != nil {
+		opt = metric.WithAttributeSet(attribute.NewSet(
+			append(spm.attrs.ToSlice(), semconv.ErrorTypeKey.String(errorType(err)))...,
+		))
+	}
+	spm.processed.Add(context.Background(), n, opt)
+	spm.exportDuration.Record(context.Background(), time.Since(start).Seconds(), opt)
+}
+
+// errorType returns the low-cardinality error.type of the failed export err.
+func errorType(err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return errorTypeTimeout
+	case errors.Is(err, context.Canceled):
+		return errorTypeCanceled
+	default:
+		return errorTypeOther
+	}
+}
+
+// shutdown stops the observation of asynchronous instruments.
+func (spm *spanProcessorMetrics) shutdown() {
+	if spm.registration == nil {
+		return
+	}
+	if err := spm.registration.Unregister(); err != nil {
+		otel.Handle(err)
+	}
+}
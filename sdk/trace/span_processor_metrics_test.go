@@ -0,0 +1,267 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+	"go.opentelemetry.io/otel/metric/noop"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+)
+
+// recordingMeterProvider is a MeterProvider that records the measurements
+// made with its instruments.
+type recordingMeterProvider struct {
+	noop.MeterProvider
+
+	mu           sync.Mutex
+	scope        string
+	counts       map[attribute.Distinct]int64
+	durations    map[attribute.Distinct]int
+	callbacks    []metric.Callback
+	unregistered int
+}
+
+func newRecordingMeterProvider() *recordingMeterProvider {
+	return &recordingMeterProvider{
+		counts:    make(map[attribute.Distinct]int64),
+		durations: make(map[attribute.Distinct]int),
+	}
+}
+
+func (mp *recordingMeterProvider) Meter(name string, _ ...metric.MeterOption) metric.Meter {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	mp.scope = name
+	return recordingMeter{mp: mp}
+}
+
+func (mp *recordingMeterProvider) count(attrs ...attribute.KeyValue) int64 {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	set := attribute.NewSet(attrs...)
+	return mp.counts[set.Equivalent()]
+}
+
+func (mp *recordingMeterProvider) exports(attrs ...attribute.KeyValue) int {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	set := attribute.NewSet(attrs...)
+	return mp.durations[set.Equivalent()]
+}
+
+// observe runs the registered callbacks and returns the observed values by
+// instrument.
+func (mp *recordingMeterProvider) observe() map[metric.Observable]int64 {
+	mp.mu.Lock()
+	callbacks := mp.callbacks
+	mp.mu.Unlock()
+
+	o := &recordingObserver{values: make(map[metric.Observable]int64)}
+	for _, cb := range callbacks {
+		_ = cb(context.Background(), o)
+	}
+	return o.values
+}
+
+type recordingMeter struct {
+	noop.Meter
+
+	mp *recordingMeterProvider
+}
+
+func (m recordingMeter) Int64Counter(string, ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return recordingCounter{mp: m.mp}, nil
+}
+
+func (m recordingMeter) Float64Histogram(string, ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	return recordingHistogram{mp: m.mp}, nil
+}
+
+func (m recordingMeter) Int64ObservableUpDownCounter(string, ...metric.Int64ObservableUpDownCounterOption) (metric.Int64ObservableUpDownCounter, error) {
+	return &noop.Int64ObservableUpDownCounter{}, nil
+}
+
+func (m recordingMeter) RegisterCallback(f metric.Callback, _ ...metric.Observable) (metric.Registration, error) {
+	m.mp.mu.Lock()
+	defer m.mp.mu.Unlock()
+	m.mp.callbacks = append(m.mp.callbacks, f)
+	return recordingRegistration{mp: m.mp, idx: len(m.mp.callbacks) - 1}, nil
+}
+
+type recordingCounter struct {
+	noop.Int64Counter
+
+	mp *recordingMeterProvider
+}
+
+func (c recordingCounter) Add(_ context.Context, incr int64, opts ...metric.AddOption) {
+	set := metric.NewAddConfig(opts).Attributes()
+	c.mp.mu.Lock()
+	defer c.mp.mu.Unlock()
+	c.mp.counts[set.Equivalent()] += incr
+}
+
+type recordingHistogram struct {
+	noop.Float64Histogram
+
+	mp *recordingMeterProvider
+}
+
+func (h recordingHistogram) Record(_ context.Context, _ float64, opts ...metric.RecordOption) {
+	set := metric.NewRecordConfig(opts).Attributes()
+	h.mp.mu.Lock()
+	defer h.mp.mu.Unlock()
+	h.mp.durations[set.Equivalent()]++
+}
+
+type recordingRegistration struct {
+	embedded.Registration
+
+	mp  *recordingMeterProvider
+	idx int
+}
+
+func (r recordingRegistration) Unregister() error {
+	r.mp.mu.Lock()
+	defer r.mp.mu.Unlock()
+	r.mp.callbacks[r.idx] = func(context.Context, metric.Observer) error { return nil }
+	r.mp.unregistered++
+	return nil
+}
+
+type recordingObserver struct {
+	embedded.Observer
+
+	values map[metric.Observable]int64
+}
+
+func (o *recordingObserver) ObserveFloat64(metric.Float64Observable, float64, ...metric.ObserveOption) {
+}
+
+func (o *recordingObserver) ObserveInt64(inst metric.Int64Observable, v int64, _ ...metric.ObserveOption) {
+	o.values[inst] = v
+}
+
+type failingExporter struct{}
+
+func (failingExporter) ExportSpans(context.Context, []ReadOnlySpan) error {
+	return errors.New("export failed")
+}
+
+func (failingExporter) Shutdown(context.Context) error { return nil }
+
+func TestSpanProcessorMetricsComponentName(t *testing.T) {
+	mp := newRecordingMeterProvider()
+	a := newSpanProcessorMetrics(mp, "test_span_processor", nil)
+	b := newSpanProcessorMetrics(mp, "test_span_processor", nil)
+
+	assert.Equal(t, selfObservabilityScope, mp.scope)
+	nameA, _ := a.attrs.Value(componentNameKey)
+	nameB, _ := b.attrs.Value(componentNameKey)
+	assert.Regexp(t, `^test_span_processor/\d+$`, nameA.AsString())
+	assert.Regexp(t, `^test_span_processor/\d+$`, nameB.AsString())
+	assert.NotEqual(t, nameA, nameB, "component names should be unique")
+}
+
+func TestSpanProcessorMetricsNilMeterProvider(t *testing.T) {
+	spm := newSpanProcessorMetrics(nil, "test_nil_span_processor", func() (int, int) { return 0, 0 })
+	assert.NotPanics(t, func() {
+		spm.dropped(1)
+		spm.exported(1, time.Now(), nil)
+		spm.shutdown()
+	})
+}
+
+func TestBatchSpanProcessorMetrics(t *testing.T) {
+	mp := newRecordingMeterProvider()
+	te := NewTestExporter()
+	bsp := NewBatchSpanProcessor(te,
+		WithBatchSpanProcessorMeterProvider(mp),
+		WithMaxQueueSize(1),
+		WithMaxExportBatchSize(1),
+	).(*batchSpanProcessor)
+	tp := NewTracerProvider(WithSpanProcessor(bsp))
+	tr := tp.Tracer("TestBatchSpanProcessorMetrics")
+
+	_, span := tr.Start(context.Background(), "span")
+	span.End()
+	require.NoError(t, tp.ForceFlush(context.Background()))
+
+	base := bsp.metrics.attrs.ToSlice()
+	assert.Equal(t, int64(1), mp.count(base...))
+	assert.Equal(t, 1, mp.exports(base...))
+
+	// Fill the queue directly, bypassing the processing goroutine, to
+	// deterministically drop a span.
+	bsp.stopOnce.Do(func() {
+		close(bsp.stopCh)
+		bsp.stopWait.Wait()
+	})
+	s := span.(ReadOnlySpan)
+	bsp.queue <- s
+	assert.False(t, bsp.enqueueDrop(context.Background(), s))
+	queueFull := append(base, semconv.ErrorTypeKey.String(errorTypeQueueFull))
+	assert.Equal(t, int64(1), mp.count(queueFull...))
+
+	observed := mp.observe()
+	assert.Len(t, observed, 2)
+	for _, v := range observed {
+		assert.Equal(t, int64(1), v, "queue size and capacity")
+	}
+
+	bsp.metrics.shutdown()
+	assert.Equal(t, 1, mp.unregistered)
+}
+
+func TestBatchSpanProcessorMetricsExportError(t *testing.T) {
+	mp := newRecordingMeterProvider()
+	bsp := NewBatchSpanProcessor(failingExporter{}, WithBatchSpanProcessorMeterProvider(mp)).(*batchSpanProcessor)
+	tp := NewTracerProvider(WithSpanProcessor(bsp))
+	t.Cleanup(handler.Reset)
+
+	_, span := tp.Tracer("TestBatchSpanProcessorMetricsExportError").Start(context.Background(), "span")
+	span.End()
+	require.NoError(t, tp.Shutdown(context.Background()))
+
+	failed := append(bsp.metrics.attrs.ToSlice(), semconv.ErrorTypeKey.String(errorTypeOther))
+	assert.Equal(t, int64(1), mp.count(failed...))
+	assert.Equal(t, 1, mp.exports(failed...))
+	assert.Equal(t, 1, mp.unregistered)
+}
+
+func TestSimpleSpanProcessorMetrics(t *testing.T) {
+	mp := newRecordingMeterProvider()
+	ssp := NewSimpleSpanProcessor(NewTestExporter(), WithSimpleSpanProcessorMeterProvider(mp)).(*simpleSpanProcessor)
+	tp := NewTracerProvider(WithSpanProcessor(ssp))
+	tr := tp.Tracer("TestSimpleSpanProcessorMetrics")
+
+	for i := 0; i < 3; i++ {
+		_, span := tr.Start(context.Background(), "span")
+		span.End()
+	}
+	require.NoError(t, tp.Shutdown(context.Background()))
+
+	base := ssp.metrics.attrs.ToSlice()
+	assert.Equal(t, int64(3), mp.count(base...))
+	assert.Equal(t, 3, mp.exports(base...))
+	assert.Empty(t, mp.observe(), "simple span processor has no queue")
+}
+
+func TestSpanProcessorMetricsErrorType(t *testing.T) {
+	assert.Equal(t, errorTypeTimeout, errorType(fmt.Errorf("export: %w", context.DeadlineExceeded)))
+	assert.Equal(t, errorTypeCanceled, errorType(context.Canceled))
+	assert.Equal(t, errorTypeOther, errorType(errors.New("export failed")))
+}
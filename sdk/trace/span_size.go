@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Estimated sizes, in bytes, of the fixed-size fields of the OTLP protobuf
+// encoding of spans, including their field tags and length prefixes.
+const (
+	// spanOverheadSize is the size of the trace ID, span ID, parent span ID,
+	// kind, start and end times, flags, dropped counts, and status code of a
+	// span.
+	spanOverheadSize = 80
+	// eventOverheadSize is the size of the time and dropped attributes count
+	// of an event.
+	eventOverheadSize = 16
+	// linkOverheadSize is the size of the trace ID, span ID, flags, and
+	// dropped attributes count of a link.
+	linkOverheadSize = 40
+	// attributeOverheadSize is the size of the tags and length prefixes of
+	// an attribute and its value.
+	attributeOverheadSize = 6
+	// numberValueSize is the size of an encoded bool, int64, or float64.
+	numberValueSize = 9
+)
+
+// estimateSpanSize returns an estimate of the size, in bytes, of the OTLP
+// protobuf encoding of s. The resource and instrumentation scope, shared by
+// the spans of a batch, are not accounted for.
+func estimateSpanSize(s ReadOnlySpan) int {
+	size := spanOverheadSize +
+		len(s.Name()) +
+		len(s.SpanContext().TraceState().String()) +
+		len(s.Status().Description) +
+		attributesSize(s.Attributes())
+	for _, e := range s.Events() {
+		size += eventOverheadSize + len(e.Name) + attributesSize(e.Attributes)
+	}
+	for _, l := range s.Links() {
+		size += linkOverheadSize + len(l.SpanContext.TraceState().String()) + attributesSize(l.Attributes)
+	}
+	return size
+}
+
+// attributesSize returns an estimate of the size, in bytes, of the OTLP
+// protobuf encoding of attrs.
+func attributesSize(attrs []attribute.KeyValue) int {
+	var size int
+	for _, a := range attrs {
+		size += attributeOverheadSize + len(a.Key) + valueSize(a.Value)
+	}
+	return size
+}
+
+func valueSize(v attribute.Value) int {
+	switch v.Type() {
+	case attribute.BOOL, attribute.INT64, attribute.FLOAT64:
+		return numberValueSize
+	case attribute.STRING:
+		return len(v.AsString())
+	case attribute.BOOLSLICE:
+		return len(v.AsBoolSlice()) * (attributeOverheadSize + numberValueSize)
+	case attribute.INT64SLICE:
+		return len(v.AsInt64Slice()) * (attributeOverheadSize + numberValueSize)
+	case attribute.FLOAT64SLICE:
+		return len(v.AsFloat64Slice()) * (attributeOverheadSize + numberValueSize)
+	case attribute.STRINGSLICE:
+		var size int
+		for _, s := range v.AsStringSlice() {
+			size += attributeOverheadSize + len(s)
+		}
+		return size
+	default:
+		return 0
+	}
+}
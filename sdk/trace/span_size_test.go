@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestEstimateSpanSize(t *testing.T) {
+	base := estimateSpanSize(&snapshot{})
+	assert.Equal(t, spanOverheadSize, base)
+
+	named := estimateSpanSize(&snapshot{name: "span"})
+	assert.Equal(t, base+len("span"), named)
+
+	attrs := []attribute.KeyValue{
+		attribute.String("key", "value"),
+		attribute.Int("int", 1),
+		attribute.StringSlice("slice", []string{"a", "bc"}),
+	}
+	want := base +
+		(attributeOverheadSize + len("key") + len("value")) +
+		(attributeOverheadSize + len("int") + numberValueSize) +
+		(attributeOverheadSize + len("slice") + 2*attributeOverheadSize + len("a") + len("bc"))
+	assert.Equal(t, want, estimateSpanSize(&snapshot{attributes: attrs}))
+
+	withEvent := estimateSpanSize(&snapshot{events: []Event{{Name: "event", Attributes: attrs[:1]}}})
+	assert.Equal(t, base+eventOverheadSize+len("event")+attributeOverheadSize+len("key")+len("value"), withEvent)
+
+	withLink := estimateSpanSize(&snapshot{links: []Link{{}}})
+	assert.Equal(t, base+linkOverheadSize, withLink)
+}
@@ -7,12 +7,16 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"runtime/pprof"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestSetStatus(t *testing.T) {
@@ -277,3 +281,116 @@ func BenchmarkRecordingSpanSetAttributes(b *testing.B) {
 		})
 	}
 }
+
+func profilerLabels(ctx context.Context) map[string]string {
+	labels := make(map[string]string)
+	pprof.ForLabels(ctx, func(k, v string) bool {
+		labels[k] = v
+		return true
+	})
+	return labels
+}
+
+func TestProfilerLabels(t *testing.T) {
+	tp := NewTracerProvider(WithProfilerLabels())
+	tr := tp.Tracer("profiled")
+
+	prior := pprof.WithLabels(context.Background(), pprof.Labels("prior", "label"))
+	pprof.SetGoroutineLabels(prior)
+	t.Cleanup(func() { pprof.SetGoroutineLabels(context.Background()) })
+
+	rootCtx, root := tr.Start(prior, "root")
+	rootID := root.SpanContext().SpanID().String()
+	assert.Equal(t, map[string]string{
+		"prior":              "label",
+		"span_id":            rootID,
+		"local_root_span_id": rootID,
+		"span_name":          "root",
+	}, profilerLabels(rootCtx))
+
+	childCtx, child := tr.Start(rootCtx, "child")
+	assert.Equal(t, map[string]string{
+		"prior":              "label",
+		"span_id":            child.SpanContext().SpanID().String(),
+		"local_root_span_id": rootID,
+		"span_name":          "child",
+	}, profilerLabels(childCtx))
+
+	remote := trace.ContextWithRemoteSpanContext(prior, child.SpanContext())
+	remoteCtx, remoteChild := tr.Start(remote, "remote")
+	remoteID := remoteChild.SpanContext().SpanID().String()
+	assert.Equal(t, remoteID, profilerLabels(remoteCtx)["local_root_span_id"], "span with remote parent is a local root")
+	remoteChild.End()
+
+	child.End()
+	root.End()
+
+	unsampledCtx, unsampled := NewTracerProvider(WithProfilerLabels(), WithSampler(NeverSample())).Tracer("unsampled").Start(prior, "unsampled")
+	assert.False(t, unsampled.IsRecording())
+	assert.Equal(t, map[string]string{"prior": "label"}, profilerLabels(unsampledCtx), "unsampled span should not be labeled")
+	unsampled.End()
+}
+
+// goroutineLabels returns the labels of the goroutine profile records
+// calling fn.
+func goroutineLabels(t *testing.T, fn string) []string {
+	var buf bytes.Buffer
+	require.NoError(t, pprof.Lookup("goroutine").WriteTo(&buf, 1))
+	var labels []string
+	for _, record := range strings.Split(buf.String(), "\n\n") {
+		if !strings.Contains(record, fn) {
+			continue
+		}
+		for _, line := range strings.Split(record, "\n") {
+			if l, ok := strings.CutPrefix(line, "# labels: "); ok {
+				labels = append(labels, l)
+			}
+		}
+	}
+	return labels
+}
+
+func TestProfilerLabelsGoroutine(t *testing.T) {
+	tr := NewTracerProvider(WithProfilerLabels()).Tracer("profiled")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ctx := pprof.WithLabels(context.Background(), pprof.Labels("prior", "label"))
+		pprof.SetGoroutineLabels(ctx)
+
+		_, span := tr.Start(ctx, "span")
+		started := goroutineLabels(t, "TestProfilerLabelsGoroutine.func")
+		span.End()
+		ended := goroutineLabels(t, "TestProfilerLabelsGoroutine.func")
+
+		if assert.Len(t, started, 1) {
+			assert.Contains(t, started[0], `"span_name":"span"`)
+		}
+		assert.Equal(t, []string{`{"prior":"label"}`}, ended)
+	}()
+	<-done
+}
+
+func TestProfilerLabelsNestedSpans(t *testing.T) {
+	tr := NewTracerProvider(WithProfilerLabels()).Tracer("profiled")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		const fn = "TestProfilerLabelsNestedSpans.func"
+
+		ctx, outer := tr.Start(context.Background(), "outer")
+		_, inner := tr.Start(ctx, "inner")
+		if labels := goroutineLabels(t, fn); assert.Len(t, labels, 1) {
+			assert.Contains(t, labels[0], `"span_name":"inner"`)
+		}
+		inner.End()
+		if labels := goroutineLabels(t, fn); assert.Len(t, labels, 1) {
+			assert.Contains(t, labels[0], `"span_name":"outer"`)
+		}
+		outer.End()
+		assert.Empty(t, goroutineLabels(t, fn))
+	}()
+	<-done
+}
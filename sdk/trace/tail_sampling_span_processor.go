@@ -0,0 +1,595 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"container/list"
+	"context"
+	"encoding/binary"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Defaults for TailSamplingSpanProcessorOptions.
+const (
+	DefaultTailSamplingDecisionWait     = 5 * time.Second
+	DefaultTailSamplingMaxTraces        = 10000
+	DefaultTailSamplingMaxSpansPerTrace = 1000
+	DefaultTailSamplingMaxQueueSize     = 2048
+	DefaultTailSamplingExportTimeout    = 30 * time.Second
+)
+
+// TailSamplingPolicy decides if a trace is kept based on its ended spans.
+type TailSamplingPolicy func(spans []ReadOnlySpan) bool
+
+// ErrorStatusPolicy returns a TailSamplingPolicy that keeps traces containing
+// a span with an Error status.
+func ErrorStatusPolicy() TailSamplingPolicy {
+	return func(spans []ReadOnlySpan) bool {
+		for _, s := range spans {
+			if s.Status().Code == codes.Error {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// LatencyPolicy returns a TailSamplingPolicy that keeps traces lasting longer
+// than threshold. The duration of a trace is measured from the earliest start
+// time to the latest end time of its spans.
+func LatencyPolicy(threshold time.Duration) TailSamplingPolicy {
+	return func(spans []ReadOnlySpan) bool {
+		if len(spans) == 0 {
+			return false
+		}
+		start, end := spans[0].StartTime(), spans[0].EndTime()
+		for _, s := range spans[1:] {
+			if s.StartTime().Before(start) {
+				start = s.StartTime()
+			}
+			if s.EndTime().After(end) {
+				end = s.EndTime()
+			}
+		}
+		return end.Sub(start) > threshold
+	}
+}
+
+// AttributePolicy returns a TailSamplingPolicy that keeps traces containing a
+// span with any of the attributes kvs.
+func AttributePolicy(kvs ...attribute.KeyValue) TailSamplingPolicy {
+	return func(spans []ReadOnlySpan) bool {
+		for _, s := range spans {
+			for _, attr := range s.Attributes() {
+				for _, kv := range kvs {
+					if attr == kv {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+}
+
+// ProbabilisticPolicy returns a TailSamplingPolicy that keeps a given fraction
+// of traces. The decision is based on the trace ID the same way the
+// TraceIDRatioBased Sampler makes its decision. Fractions >= 1 keep all
+// traces, fractions <= 0 keep no traces.
+func ProbabilisticPolicy(fraction float64) TailSamplingPolicy {
+	if fraction >= 1 {
+		return func([]ReadOnlySpan) bool { return true }
+	}
+	if fraction <= 0 {
+		return func([]ReadOnlySpan) bool { return false }
+	}
+	upperBound := uint64(fraction * (1 << 63))
+	return func(spans []ReadOnlySpan) bool {
+		if len(spans) == 0 {
+			return false
+		}
+		tid := spans[0].SpanContext().TraceID()
+		return binary.BigEndian.Uint64(tid[8:16])>>1 < upperBound
+	}
+}
+
+// TailSamplingSpanProcessorOption configures a TailSamplingSpanProcessor.
+type TailSamplingSpanProcessorOption func(o *TailSamplingSpanProcessorOptions)
+
+// TailSamplingSpanProcessorOptions is configuration settings for a
+// TailSamplingSpanProcessor.
+type TailSamplingSpanProcessorOptions struct {
+	// DecisionWait is the maximum duration spans of a trace are buffered
+	// before a decision is made for the trace. A decision is made before
+	// this duration elapses if the local root span of the trace ends.
+	// The default value of DecisionWait is 5 seconds.
+	DecisionWait time.Duration
+
+	// MaxTraces is the maximum number of traces buffered at any time. When
+	// the limit is reached, the oldest buffered trace is dropped to make
+	// room for a new trace.
+	// The default value of MaxTraces is 10000.
+	MaxTraces int
+
+	// MaxSpansPerTrace is the maximum number of spans buffered for a single
+	// trace. Spans ended after this limit is reached are dropped.
+	// The default value of MaxSpansPerTrace is 1000.
+	MaxSpansPerTrace int
+
+	// MaxQueueSize is the maximum number of spans of kept traces waiting to
+	// be exported. A kept trace whose buffered spans do not fit in the queue
+	// is dropped whole rather than exported partially. Spans ending after
+	// the decision for their trace was made are dropped when the queue is
+	// full.
+	// The default value of MaxQueueSize is 2048.
+	MaxQueueSize int
+
+	// ExportTimeout specifies the maximum duration for exporting the spans
+	// of kept traces. The default value of ExportTimeout is 30 seconds.
+	ExportTimeout time.Duration
+
+	// Policies are evaluated to decide if a trace is kept. A trace is kept
+	// if any of the policies keeps it. If no policies are configured, all
+	// traces are kept.
+	Policies []TailSamplingPolicy
+}
+
+// WithTailSamplingDecisionWait returns a TailSamplingSpanProcessorOption
+// that configures the maximum duration spans of a trace are buffered before a
+// decision is made for the trace.
+func WithTailSamplingDecisionWait(wait time.Duration) TailSamplingSpanProcessorOption {
+	return func(o *TailSamplingSpanProcessorOptions) {
+		o.DecisionWait = wait
+	}
+}
+
+// WithTailSamplingMaxTraces returns a TailSamplingSpanProcessorOption that
+// configures the maximum number of traces buffered by a
+// TailSamplingSpanProcessor.
+func WithTailSamplingMaxTraces(n int) TailSamplingSpanProcessorOption {
+	return func(o *TailSamplingSpanProcessorOptions) {
+		o.MaxTraces = n
+	}
+}
+
+// WithTailSamplingMaxSpansPerTrace returns a TailSamplingSpanProcessorOption
+// that configures the maximum number of spans buffered for a single trace by
+// a TailSamplingSpanProcessor.
+func WithTailSamplingMaxSpansPerTrace(n int) TailSamplingSpanProcessorOption {
+	return func(o *TailSamplingSpanProcessorOptions) {
+		o.MaxSpansPerTrace = n
+	}
+}
+
+// WithTailSamplingMaxQueueSize returns a TailSamplingSpanProcessorOption that
+// configures the maximum number of spans of kept traces waiting to be
+// exported by a TailSamplingSpanProcessor.
+func WithTailSamplingMaxQueueSize(size int) TailSamplingSpanProcessorOption {
+	return func(o *TailSamplingSpanProcessorOptions) {
+		o.MaxQueueSize = size
+	}
+}
+
+// WithTailSamplingExportTimeout returns a TailSamplingSpanProcessorOption
+// that configures the maximum duration for exporting the spans of kept
+// traces.
+func WithTailSamplingExportTimeout(timeout time.Duration) TailSamplingSpanProcessorOption {
+	return func(o *TailSamplingSpanProcessorOptions) {
+		o.ExportTimeout = timeout
+	}
+}
+
+// WithTailSamplingPolicies returns a TailSamplingSpanProcessorOption that
+// adds policies to the policies evaluated by a TailSamplingSpanProcessor.
+func WithTailSamplingPolicies(policies ...TailSamplingPolicy) TailSamplingSpanProcessorOption {
+	return func(o *TailSamplingSpanProcessorOptions) {
+		o.Policies = append(o.Policies, policies...)
+	}
+}
+
+// tailTrace holds the buffered spans of a trace.
+type tailTrace struct {
+	id        trace.TraceID
+	firstSeen time.Time
+	spans     []ReadOnlySpan
+}
+
+// tailDecision is the decision made for a trace.
+type tailDecision struct {
+	id        trace.TraceID
+	decidedAt time.Time
+	kept      bool
+}
+
+// TailSamplingSpanProcessor is a SpanProcessor that buffers the ended spans
+// of each trace and decides if the trace is exported once all of its spans
+// are expected to have ended.
+//
+// A decision is made for a trace when its local root span ends, a span
+// without a parent or with a remote parent, or when the decision wait of the
+// trace expires. Kept traces are exported to the wrapped SpanExporter.
+// Decisions are remembered for the decision wait so spans of a trace ending
+// after its decision was made are kept or dropped with the rest of the trace.
+//
+// All ended spans are evaluated regardless of their sampled flag. The
+// TracerProvider should therefore be configured with a Sampler that records
+// all spans the policies need to evaluate.
+type TailSamplingSpanProcessor struct {
+	e SpanExporter
+	o TailSamplingSpanProcessorOptions
+
+	// mu protects traces, order, decided, decidedOrder, and pending.
+	mu           sync.Mutex
+	traces       map[trace.TraceID]*list.Element
+	order        *list.List
+	decided      map[trace.TraceID]*list.Element
+	decidedOrder *list.List
+	pending      []ReadOnlySpan
+
+	exportMu sync.Mutex
+
+	droppedTraces atomic.Uint64
+	droppedSpans  atomic.Uint64
+
+	signal   chan struct{}
+	stopCh   chan struct{}
+	stopWait sync.WaitGroup
+	stopOnce sync.Once
+	stopped  atomic.Bool
+}
+
+var _ SpanProcessor = (*TailSamplingSpanProcessor)(nil)
+
+// NewTailSamplingSpanProcessor returns a new TailSamplingSpanProcessor that
+// exports kept traces to exporter using the supplied options.
+//
+// If the exporter is nil, the span processor will perform no action.
+func NewTailSamplingSpanProcessor(exporter SpanExporter, options ...TailSamplingSpanProcessorOption) *TailSamplingSpanProcessor {
+	o := TailSamplingSpanProcessorOptions{
+		DecisionWait:     DefaultTailSamplingDecisionWait,
+		MaxTraces:        DefaultTailSamplingMaxTraces,
+		MaxSpansPerTrace: DefaultTailSamplingMaxSpansPerTrace,
+		MaxQueueSize:     DefaultTailSamplingMaxQueueSize,
+		ExportTimeout:    DefaultTailSamplingExportTimeout,
+	}
+	for _, opt := range options {
+		opt(&o)
+	}
+	if o.DecisionWait <= 0 {
+		o.DecisionWait = DefaultTailSamplingDecisionWait
+	}
+	if o.MaxTraces <= 0 {
+		o.MaxTraces = DefaultTailSamplingMaxTraces
+	}
+	if o.MaxSpansPerTrace <= 0 {
+		o.MaxSpansPerTrace = DefaultTailSamplingMaxSpansPerTrace
+	}
+	if o.MaxQueueSize <= 0 {
+		o.MaxQueueSize = DefaultTailSamplingMaxQueueSize
+	}
+	if o.ExportTimeout <= 0 {
+		o.ExportTimeout = DefaultTailSamplingExportTimeout
+	}
+
+	tsp := &TailSamplingSpanProcessor{
+		e:            exporter,
+		o:            o,
+		traces:       make(map[trace.TraceID]*list.Element),
+		order:        list.New(),
+		decided:      make(map[trace.TraceID]*list.Element),
+		decidedOrder: list.New(),
+		signal:       make(chan struct{}, 1),
+		stopCh:       make(chan struct{}),
+	}
+
+	tsp.stopWait.Add(1)
+	go func() {
+		defer tsp.stopWait.Done()
+		tsp.processTraces()
+	}()
+
+	return tsp
+}
+
+// OnStart method does nothing.
+func (tsp *TailSamplingSpanProcessor) OnStart(context.Context, ReadWriteSpan) {}
+
+// OnEnd buffers the ended span s with the other spans of its trace. If s is
+// the local root span of its trace, a decision is made for the trace. If a
+// decision was already made for the trace, s is kept or dropped accordingly.
+func (tsp *TailSamplingSpanProcessor) OnEnd(s ReadOnlySpan) {
+	if tsp.stopped.Load() || tsp.e == nil {
+		return
+	}
+
+	tid := s.SpanContext().TraceID()
+	isLocalRoot := !s.Parent().IsValid() || s.Parent().IsRemote()
+
+	tsp.mu.Lock()
+	if d, ok := tsp.decided[tid]; ok {
+		kept := d.Value.(*tailDecision).kept
+		if kept {
+			kept = tsp.enqueue([]ReadOnlySpan{s})
+		}
+		tsp.mu.Unlock()
+		if kept {
+			tsp.notify()
+		}
+		return
+	}
+
+	elem, ok := tsp.traces[tid]
+	if !ok {
+		if tsp.order.Len() >= tsp.o.MaxTraces {
+			tsp.evictOldest()
+		}
+		elem = tsp.order.PushBack(&tailTrace{id: tid, firstSeen: time.Now()})
+		tsp.traces[tid] = elem
+	}
+	tt := elem.Value.(*tailTrace)
+	if len(tt.spans) < tsp.o.MaxSpansPerTrace {
+		tt.spans = append(tt.spans, s)
+	} else {
+		tsp.droppedSpans.Add(1)
+	}
+	var decided bool
+	if isLocalRoot {
+		decided = tsp.decide(elem)
+	}
+	tsp.mu.Unlock()
+
+	if decided {
+		tsp.notify()
+	}
+}
+
+// evictOldest drops the oldest buffered trace.
+//
+// This method assumes tsp.mu is held by the caller.
+func (tsp *TailSamplingSpanProcessor) evictOldest() {
+	elem := tsp.order.Front()
+	if elem == nil {
+		return
+	}
+	tt := tsp.order.Remove(elem).(*tailTrace)
+	delete(tsp.traces, tt.id)
+	if tsp.droppedTraces.Add(1) == 1 {
+		global.Warn("tail sampling buffer full: dropping traces", "max_traces", tsp.o.MaxTraces)
+	}
+}
+
+// decide removes the trace held by elem from the buffer and evaluates the
+// policies for it. The decision is remembered for spans of the trace ending
+// later. If the trace is kept, its spans are added to the pending spans to
+// export and true is returned. A kept trace that does not fit in the export
+// queue is dropped and remembered as such.
+//
+// This method assumes tsp.mu is held by the caller.
+func (tsp *TailSamplingSpanProcessor) decide(elem *list.Element) bool {
+	tt := tsp.order.Remove(elem).(*tailTrace)
+	delete(tsp.traces, tt.id)
+
+	kept := tsp.keep(tt.spans)
+	if kept && !tsp.enqueue(tt.spans) {
+		// The trace did not fit in the export queue and was dropped whole.
+		tsp.droppedTraces.Add(1)
+		kept = false
+	}
+	if tsp.decidedOrder.Len() >= tsp.o.MaxTraces {
+		d := tsp.decidedOrder.Remove(tsp.decidedOrder.Front()).(*tailDecision)
+		delete(tsp.decided, d.id)
+	}
+	tsp.decided[tt.id] = tsp.decidedOrder.PushBack(&tailDecision{
+		id:        tt.id,
+		decidedAt: time.Now(),
+		kept:      kept,
+	})
+
+	return kept
+}
+
+// enqueue adds spans to the pending spans to export. If spans do not all fit
+// in the export queue, none of them are added, they are counted as dropped,
+// and false is returned.
+//
+// This method assumes tsp.mu is held by the caller.
+func (tsp *TailSamplingSpanProcessor) enqueue(spans []ReadOnlySpan) bool {
+	if len(spans) > tsp.o.MaxQueueSize-len(tsp.pending) {
+		n := uint64(len(spans))
+		if tsp.droppedSpans.Add(n) == n {
+			global.Warn("tail sampling export queue full: dropping spans", "max_queue_size", tsp.o.MaxQueueSize)
+		}
+		return false
+	}
+	tsp.pending = append(tsp.pending, spans...)
+	return true
+}
+
+// keep reports whether any of the policies keeps the trace made of spans.
+func (tsp *TailSamplingSpanProcessor) keep(spans []ReadOnlySpan) bool {
+	if len(tsp.o.Policies) == 0 {
+		return true
+	}
+	for _, p := range tsp.o.Policies {
+		if p(spans) {
+			return true
+		}
+	}
+	return false
+}
+
+// notify wakes up the processing goroutine to export pending spans.
+func (tsp *TailSamplingSpanProcessor) notify() {
+	select {
+	case tsp.signal <- struct{}{}:
+	default:
+	}
+}
+
+// processTraces makes a decision for traces whose decision wait expired and
+// exports kept traces until the processor is shut down.
+func (tsp *TailSamplingSpanProcessor) processTraces() {
+	interval := tsp.o.DecisionWait / 10
+	if interval < time.Millisecond {
+		interval = time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for {
+		select {
+		case <-tsp.stopCh:
+			return
+		case <-ticker.C:
+			tsp.expire(time.Now())
+		case <-tsp.signal:
+		}
+		exportCtx, exportCancel := context.WithTimeout(ctx, tsp.o.ExportTimeout)
+		if err := tsp.export(exportCtx); err != nil {
+			otel.Handle(err)
+		}
+		exportCancel()
+	}
+}
+
+// expire makes a decision for all traces first seen before now minus the
+// decision wait, and forgets the decisions made before that time.
+func (tsp *TailSamplingSpanProcessor) expire(now time.Time) {
+	tsp.mu.Lock()
+	defer tsp.mu.Unlock()
+
+	deadline := now.Add(-tsp.o.DecisionWait)
+	for elem := tsp.decidedOrder.Front(); elem != nil; elem = tsp.decidedOrder.Front() {
+		d := elem.Value.(*tailDecision)
+		if d.decidedAt.After(deadline) {
+			break
+		}
+		tsp.decidedOrder.Remove(elem)
+		delete(tsp.decided, d.id)
+	}
+	for elem := tsp.order.Front(); elem != nil; elem = tsp.order.Front() {
+		if elem.Value.(*tailTrace).firstSeen.After(deadline) {
+			return
+		}
+		tsp.decide(elem)
+	}
+}
+
+// export exports all pending spans.
+func (tsp *TailSamplingSpanProcessor) export(ctx context.Context) error {
+	tsp.exportMu.Lock()
+	defer tsp.exportMu.Unlock()
+
+	tsp.mu.Lock()
+	spans := tsp.pending
+	tsp.pending = nil
+	tsp.mu.Unlock()
+
+	if len(spans) == 0 {
+		return nil
+	}
+	return tsp.e.ExportSpans(ctx, spans)
+}
+
+// Shutdown makes a decision for all buffered traces, exports the kept ones,
+// and shuts down the exporter. It only executes once. Subsequent calls do
+// nothing.
+func (tsp *TailSamplingSpanProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	tsp.stopOnce.Do(func() {
+		tsp.stopped.Store(true)
+		wait := make(chan error, 1)
+		go func() {
+			close(tsp.stopCh)
+			tsp.stopWait.Wait()
+			if tsp.e == nil {
+				wait <- nil
+				return
+			}
+
+			tsp.decideAll()
+			if err := tsp.export(ctx); err != nil {
+				otel.Handle(err)
+			}
+			wait <- tsp.e.Shutdown(ctx)
+		}()
+		// Wait until the exporter is shut down or the context is cancelled.
+		select {
+		case err = <-wait:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	})
+	return err
+}
+
+// ForceFlush makes a decision for all buffered traces and exports the kept
+// ones.
+func (tsp *TailSamplingSpanProcessor) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if tsp.stopped.Load() || tsp.e == nil {
+		return nil
+	}
+
+	tsp.decideAll()
+	wait := make(chan error, 1)
+	go func() {
+		wait <- tsp.export(ctx)
+	}()
+	select {
+	case err := <-wait:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// decideAll makes a decision for all buffered traces.
+func (tsp *TailSamplingSpanProcessor) decideAll() {
+	tsp.mu.Lock()
+	defer tsp.mu.Unlock()
+	for elem := tsp.order.Front(); elem != nil; elem = tsp.order.Front() {
+		tsp.decide(elem)
+	}
+}
+
+// DroppedTraces returns the number of traces dropped without a decision
+// because the maximum number of buffered traces was reached, and the number
+// of kept traces dropped whole because they did not fit in the export queue.
+func (tsp *TailSamplingSpanProcessor) DroppedTraces() uint64 {
+	return tsp.droppedTraces.Load()
+}
+
+// DroppedSpans returns the number of spans dropped because the maximum
+// number of spans buffered for their trace, or the maximum export queue size,
+// was reached.
+func (tsp *TailSamplingSpanProcessor) DroppedSpans() uint64 {
+	return tsp.droppedSpans.Load()
+}
+
+// MarshalLog is the marshaling function used by the logging system to
+// represent this Span Processor.
+func (tsp *TailSamplingSpanProcessor) MarshalLog() interface{} {
+	return struct {
+		Type         string
+		SpanExporter SpanExporter
+		Config       TailSamplingSpanProcessorOptions
+	}{
+		Type:         "TailSamplingSpanProcessor",
+		SpanExporter: tsp.e,
+		Config:       tsp.o,
+	}
+}
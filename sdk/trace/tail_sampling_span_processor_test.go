@@ -0,0 +1,310 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTailSamplingTracer(t *testing.T, opts ...sdktrace.TailSamplingSpanProcessorOption) (*sdktrace.TailSamplingSpanProcessor, *tracetest.InMemoryExporter, trace.Tracer) {
+	exp := tracetest.NewInMemoryExporter()
+	tsp := sdktrace.NewTailSamplingSpanProcessor(exp, opts...)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(tsp))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	return tsp, exp, tp.Tracer("TailSampling")
+}
+
+func spanNames(stubs tracetest.SpanStubs) []string {
+	names := make([]string, len(stubs))
+	for i, s := range stubs {
+		names[i] = s.Name
+	}
+	return names
+}
+
+func TestTailSamplingErrorStatusPolicy(t *testing.T) {
+	tsp, exp, tr := newTailSamplingTracer(t,
+		sdktrace.WithTailSamplingDecisionWait(time.Hour),
+		sdktrace.WithTailSamplingPolicies(sdktrace.ErrorStatusPolicy()),
+	)
+
+	ctx, root := tr.Start(context.Background(), "ok-root")
+	_, child := tr.Start(ctx, "ok-child")
+	child.End()
+	root.End()
+
+	ctx, root = tr.Start(context.Background(), "err-root")
+	_, child = tr.Start(ctx, "err-child")
+	child.SetStatus(codes.Error, "failed")
+	child.End()
+	root.End()
+
+	require.NoError(t, tsp.ForceFlush(context.Background()))
+	assert.ElementsMatch(t, []string{"err-child", "err-root"}, spanNames(exp.GetSpans()))
+}
+
+func TestTailSamplingLatencyPolicy(t *testing.T) {
+	tsp, exp, tr := newTailSamplingTracer(t,
+		sdktrace.WithTailSamplingDecisionWait(time.Hour),
+		sdktrace.WithTailSamplingPolicies(sdktrace.LatencyPolicy(time.Second)),
+	)
+
+	start := time.Now()
+	_, span := tr.Start(context.Background(), "fast", trace.WithTimestamp(start))
+	span.End(trace.WithTimestamp(start.Add(time.Millisecond)))
+	_, span = tr.Start(context.Background(), "slow", trace.WithTimestamp(start))
+	span.End(trace.WithTimestamp(start.Add(2 * time.Second)))
+
+	require.NoError(t, tsp.ForceFlush(context.Background()))
+	assert.Equal(t, []string{"slow"}, spanNames(exp.GetSpans()))
+}
+
+func TestTailSamplingAttributeAndProbabilisticPolicies(t *testing.T) {
+	tsp, exp, tr := newTailSamplingTracer(t,
+		sdktrace.WithTailSamplingDecisionWait(time.Hour),
+		sdktrace.WithTailSamplingPolicies(
+			sdktrace.AttributePolicy(attribute.Bool("keep", true)),
+			sdktrace.ProbabilisticPolicy(0),
+		),
+	)
+
+	_, span := tr.Start(context.Background(), "kept", trace.WithAttributes(attribute.Bool("keep", true)))
+	span.End()
+	_, span = tr.Start(context.Background(), "dropped", trace.WithAttributes(attribute.Bool("keep", false)))
+	span.End()
+
+	require.NoError(t, tsp.ForceFlush(context.Background()))
+	assert.Equal(t, []string{"kept"}, spanNames(exp.GetSpans()))
+}
+
+func TestTailSamplingDecisionWait(t *testing.T) {
+	_, exp, tr := newTailSamplingTracer(t, sdktrace.WithTailSamplingDecisionWait(10*time.Millisecond))
+
+	// The root span never ends, the decision is made when the wait expires.
+	ctx, _ := tr.Start(context.Background(), "root")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+
+	assert.Eventually(t, func() bool {
+		return len(exp.GetSpans()) == 1
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestTailSamplingLocalRootDecision(t *testing.T) {
+	_, exp, tr := newTailSamplingTracer(t, sdktrace.WithTailSamplingDecisionWait(time.Hour))
+
+	ctx, root := tr.Start(context.Background(), "root")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+	assert.Empty(t, exp.GetSpans(), "trace should be buffered until the local root ends")
+
+	root.End()
+	assert.Eventually(t, func() bool {
+		return len(exp.GetSpans()) == 2
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestTailSamplingMemoryBounds(t *testing.T) {
+	tsp, exp, tr := newTailSamplingTracer(t,
+		sdktrace.WithTailSamplingDecisionWait(time.Hour),
+		sdktrace.WithTailSamplingMaxTraces(2),
+		sdktrace.WithTailSamplingMaxSpansPerTrace(2),
+	)
+
+	// Three traces whose local roots have not ended.
+	var roots []trace.Span
+	for i := 0; i < 3; i++ {
+		ctx, root := tr.Start(context.Background(), "root")
+		roots = append(roots, root)
+		for j := 0; j < 3; j++ {
+			_, child := tr.Start(ctx, "child")
+			child.End()
+		}
+	}
+
+	assert.Equal(t, uint64(1), tsp.DroppedTraces())
+	assert.Equal(t, uint64(3), tsp.DroppedSpans())
+
+	require.NoError(t, tsp.ForceFlush(context.Background()))
+	assert.Len(t, exp.GetSpans(), 4)
+
+	for _, root := range roots {
+		root.End()
+	}
+}
+
+func TestTailSamplingShutdown(t *testing.T) {
+	exp := &testBatchExporter{}
+	tsp := sdktrace.NewTailSamplingSpanProcessor(exp, sdktrace.WithTailSamplingDecisionWait(time.Hour))
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(tsp))
+
+	ctx, root := tp.Tracer("TailSampling").Start(context.Background(), "root")
+	_, child := tp.Tracer("TailSampling").Start(ctx, "child")
+	child.End()
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+	assert.Equal(t, 1, exp.len(), "buffered spans should be exported on shutdown")
+	assert.Equal(t, 1, exp.shutdownCount)
+
+	root.End()
+	assert.NoError(t, tsp.ForceFlush(context.Background()))
+	assert.NoError(t, tsp.Shutdown(context.Background()))
+}
+
+func TestTailSamplingNilExporter(t *testing.T) {
+	tsp := sdktrace.NewTailSamplingSpanProcessor(nil)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(tsp))
+	_, span := tp.Tracer("TailSampling").Start(context.Background(), "span")
+	span.End()
+	assert.NoError(t, tp.ForceFlush(context.Background()))
+	assert.NoError(t, tp.Shutdown(context.Background()))
+}
+
+func TestTailSamplingLateSpans(t *testing.T) {
+	tsp, exp, tr := newTailSamplingTracer(t,
+		sdktrace.WithTailSamplingDecisionWait(time.Hour),
+		sdktrace.WithTailSamplingPolicies(sdktrace.ErrorStatusPolicy()),
+	)
+
+	ctx, root := tr.Start(context.Background(), "err-root")
+	_, late := tr.Start(ctx, "err-late")
+	root.SetStatus(codes.Error, "failed")
+	root.End()
+	late.End()
+
+	ctx, root = tr.Start(context.Background(), "ok-root")
+	_, late = tr.Start(ctx, "ok-late")
+	root.End()
+	// The trace was already dropped, late spans are dropped with it.
+	late.SetStatus(codes.Error, "failed")
+	late.End()
+
+	require.NoError(t, tsp.ForceFlush(context.Background()))
+	assert.ElementsMatch(t, []string{"err-root", "err-late"}, spanNames(exp.GetSpans()))
+}
+
+// blockingExporter blocks exports until released or the export ctx is done.
+type blockingExporter struct {
+	tracetest.InMemoryExporter
+
+	started chan struct{}
+	release chan struct{}
+	errs    chan error
+}
+
+func newBlockingExporter() *blockingExporter {
+	return &blockingExporter{
+		started: make(chan struct{}, 10),
+		release: make(chan struct{}),
+		errs:    make(chan error, 10),
+	}
+}
+
+func (e *blockingExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.started <- struct{}{}
+	select {
+	case <-e.release:
+	case <-ctx.Done():
+		e.errs <- ctx.Err()
+		return ctx.Err()
+	}
+	return e.InMemoryExporter.ExportSpans(ctx, spans)
+}
+
+func TestTailSamplingQueueBound(t *testing.T) {
+	exp := newBlockingExporter()
+	tsp := sdktrace.NewTailSamplingSpanProcessor(exp,
+		sdktrace.WithTailSamplingDecisionWait(time.Hour),
+		sdktrace.WithTailSamplingMaxQueueSize(2),
+	)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(tsp))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	tr := tp.Tracer("TailSampling")
+
+	_, span := tr.Start(context.Background(), "exporting")
+	span.End()
+	<-exp.started
+
+	// The exporter is blocked, only 2 of these spans fit in the queue.
+	for i := 0; i < 3; i++ {
+		_, span := tr.Start(context.Background(), "queued")
+		span.End()
+	}
+	assert.Equal(t, uint64(1), tsp.DroppedSpans())
+	assert.Equal(t, uint64(1), tsp.DroppedTraces())
+
+	close(exp.release)
+	require.NoError(t, tsp.ForceFlush(context.Background()))
+	assert.ElementsMatch(t, []string{"exporting", "queued", "queued"}, spanNames(exp.GetSpans()))
+}
+
+func TestTailSamplingQueueBoundWholeTrace(t *testing.T) {
+	exp := newBlockingExporter()
+	tsp := sdktrace.NewTailSamplingSpanProcessor(exp,
+		sdktrace.WithTailSamplingDecisionWait(time.Hour),
+		sdktrace.WithTailSamplingMaxQueueSize(3),
+	)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(tsp))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	tr := tp.Tracer("TailSampling")
+
+	_, span := tr.Start(context.Background(), "exporting")
+	span.End()
+	<-exp.started
+
+	_, span = tr.Start(context.Background(), "queued")
+	span.End()
+
+	// The exporter is blocked and only 2 more spans fit in the queue. The
+	// kept trace of 3 spans must not be exported partially.
+	ctx, root := tr.Start(context.Background(), "root")
+	for i := 0; i < 2; i++ {
+		_, child := tr.Start(ctx, "child")
+		child.End()
+	}
+	root.End()
+	assert.Equal(t, uint64(1), tsp.DroppedTraces())
+	assert.Equal(t, uint64(3), tsp.DroppedSpans())
+
+	// Spans of the dropped trace ending later are dropped as well.
+	_, late := tr.Start(ctx, "late")
+	late.End()
+	assert.Equal(t, uint64(3), tsp.DroppedSpans())
+
+	close(exp.release)
+	require.NoError(t, tsp.ForceFlush(context.Background()))
+	assert.ElementsMatch(t, []string{"exporting", "queued"}, spanNames(exp.GetSpans()))
+}
+
+func TestTailSamplingExportTimeout(t *testing.T) {
+	exp := newBlockingExporter()
+	tsp := sdktrace.NewTailSamplingSpanProcessor(exp,
+		sdktrace.WithTailSamplingDecisionWait(time.Hour),
+		sdktrace.WithTailSamplingExportTimeout(10*time.Millisecond),
+	)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(tsp))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	tr := tp.Tracer("TailSampling")
+
+	_, span := tr.Start(context.Background(), "span")
+	span.End()
+	select {
+	case err := <-exp.errs:
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	case <-time.After(time.Second):
+		t.Fatal("export not timed out")
+	}
+	close(exp.release)
+}
@@ -5,11 +5,13 @@ package trace // import "go.opentelemetry.io/otel/sdk/trace"
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/embedded"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 type tracer struct {
@@ -17,6 +19,10 @@ type tracer struct {
 
 	provider             *TracerProvider
 	instrumentationScope instrumentation.Scope
+
+	// config is the TracerConfig of the tracer. If nil, the configuration
+	// of the provider is used.
+	config atomic.Pointer[TracerConfig]
 }
 
 var _ trace.Tracer = &tracer{}
@@ -34,6 +40,10 @@ func (tr *tracer) Start(ctx context.Context, name string, options ...trace.SpanS
 		ctx = context.Background()
 	}
 
+	if cfg := tr.config.Load(); cfg != nil && cfg.Disabled {
+		return noop.Tracer{}.Start(ctx, name, options...)
+	}
+
 	// For local spans created by this SDK, track child span count.
 	if p := trace.SpanFromContext(ctx); p != nil {
 		if sdkSpan, ok := p.(*recordingSpan); ok {
@@ -51,6 +61,9 @@ func (tr *tracer) Start(ctx context.Context, name string, options ...trace.SpanS
 	if rtt, ok := s.(runtimeTracer); ok {
 		ctx = rtt.runtimeTrace(ctx)
 	}
+	if rs, ok := s.(*recordingSpan); ok && tr.provider.profilerLabels {
+		ctx = rs.profilerLabels(ctx)
+	}
 
 	return trace.ContextWithSpan(ctx, s), s
 }
@@ -84,7 +97,7 @@ func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanCo
 		sid = tr.provider.idGenerator.NewSpanID(ctx, tid)
 	}
 
-	samplingResult := tr.provider.sampler.ShouldSample(SamplingParameters{
+	samplingResult := tr.sampler().ShouldSample(SamplingParameters{
 		ParentContext: ctx,
 		TraceID:       tid,
 		Name:          name,
@@ -118,6 +131,7 @@ func (tr *tracer) newRecordingSpan(psc, sc trace.SpanContext, name string, sr Sa
 		startTime = time.Now()
 	}
 
+	limits := tr.spanLimits()
 	s := &recordingSpan{
 		// Do not pre-allocate the attributes slice here! Doing so will
 		// allocate memory that is likely never going to be used, or if used,
@@ -132,8 +146,8 @@ func (tr *tracer) newRecordingSpan(psc, sc trace.SpanContext, name string, sr Sa
 		spanKind:    trace.ValidateSpanKind(config.SpanKind()),
 		name:        name,
 		startTime:   startTime,
-		events:      newEvictedQueue[Event](tr.provider.spanLimits.EventCountLimit),
-		links:       newEvictedQueue[Link](tr.provider.spanLimits.LinkCountLimit),
+		events:      newEvictedQueue[Event](limits.EventCountLimit),
+		links:       newEvictedQueue[Link](limits.LinkCountLimit),
 		tracer:      tr,
 	}
 
@@ -147,6 +161,22 @@ func (tr *tracer) newRecordingSpan(psc, sc trace.SpanContext, name string, sr Sa
 	return s
 }
 
+// sampler returns the Sampler used by tr.
+func (tr *tracer) sampler() Sampler {
+	if cfg := tr.config.Load(); cfg != nil && cfg.Sampler != nil {
+		return cfg.Sampler
+	}
+	return tr.provider.getSampler()
+}
+
+// spanLimits returns the SpanLimits used by tr.
+func (tr *tracer) spanLimits() *SpanLimits {
+	if cfg := tr.config.Load(); cfg != nil && cfg.SpanLimits != nil {
+		return cfg.SpanLimits
+	}
+	return tr.provider.getSpanLimits()
+}
+
 // newNonRecordingSpan returns a new configured nonRecordingSpan.
 func (tr *tracer) newNonRecordingSpan(sc trace.SpanContext) nonRecordingSpan {
 	return nonRecordingSpan{tracer: tr, sc: sc}
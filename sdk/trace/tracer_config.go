@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+// TracerConfig is the configuration of the Tracers of an instrumentation
+// scope.
+type TracerConfig struct {
+	// Disabled, if true, makes the Tracer behave like a no-op Tracer: the
+	// spans it starts are non-recording and only propagate the span context
+	// of their parent.
+	Disabled bool
+	// Sampler, if not nil, is used instead of the Sampler of the
+	// TracerProvider to make the sampling decision of the spans started by
+	// the Tracer.
+	Sampler Sampler
+	// SpanLimits, if not nil, are used instead of the SpanLimits of the
+	// TracerProvider to bound the spans started by the Tracer. The limits
+	// are used as-is, see WithRawSpanLimits.
+	SpanLimits *SpanLimits
+}
+
+// TracerConfigurator returns the TracerConfig of the Tracers of an
+// instrumentation scope.
+//
+// A TracerConfigurator is called while the TracerProvider holds a lock, it
+// needs to be fast and must not call the TracerProvider.
+type TracerConfigurator func(instrumentation.Scope) TracerConfig
+
+// WithTracerConfigurator returns a TracerProviderOption that configures the
+// TracerConfigurator used to configure the Tracers of the TracerProvider per
+// instrumentation scope. Use TracerProvider.SetTracerConfigurator to update
+// it at runtime.
+//
+// If this option is not used, all Tracers use the configuration of the
+// TracerProvider.
+func WithTracerConfigurator(c TracerConfigurator) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.tracerConfigurator = c
+		return cfg
+	})
+}
+
+// SetTracerConfigurator replaces the TracerConfigurator of p. The
+// configuration of all Tracers already returned by p is updated, spans
+// already started keep the Sampler decision they were started with. If c is
+// nil, all Tracers use the configuration of p.
+//
+// This method is safe to be called concurrently.
+func (p *TracerProvider) SetTracerConfigurator(c TracerConfigurator) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.tracerConfigurator = c
+	for _, t := range p.namedTracer {
+		p.configureTracer(t)
+	}
+}
+
+// configureTracer stores the TracerConfig of t. p.mu must be held.
+func (p *TracerProvider) configureTracer(t *tracer) {
+	if p.tracerConfigurator == nil {
+		t.config.Store(nil)
+		return
+	}
+	cfg := p.tracerConfigurator(t.instrumentationScope)
+	if cfg.SpanLimits != nil {
+		// Copy the limits so they cannot be changed after being configured.
+		limits := *cfg.SpanLimits
+		cfg.SpanLimits = &limits
+	}
+	t.config.Store(&cfg)
+}
@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracerConfiguratorDisabled(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithTracerConfigurator(func(s instrumentation.Scope) TracerConfig {
+		return TracerConfig{Disabled: s.Name == "chatty"}
+	}))
+
+	ctx, parent := tp.Tracer("app").Start(context.Background(), "parent")
+	_, span := tp.Tracer("chatty").Start(ctx, "chatty")
+	assert.False(t, span.IsRecording())
+	assert.Equal(t, parent.SpanContext(), span.SpanContext(), "disabled tracer should propagate the parent span context")
+	span.End()
+	parent.End()
+
+	require.Equal(t, 1, te.Len())
+	assert.Equal(t, "parent", te.Spans()[0].Name())
+}
+
+func TestTracerConfiguratorSamplerAndSpanLimits(t *testing.T) {
+	te := NewTestExporter()
+	limits := NewSpanLimits()
+	limits.AttributeCountLimit = 1
+	tp := NewTracerProvider(WithSyncer(te), WithTracerConfigurator(func(s instrumentation.Scope) TracerConfig {
+		switch s.Name {
+		case "sampled-out":
+			return TracerConfig{Sampler: NeverSample()}
+		case "limited":
+			return TracerConfig{SpanLimits: &limits}
+		}
+		return TracerConfig{}
+	}))
+	limited := tp.Tracer("limited")
+	// Changing the limits after they are configured has no effect.
+	limits.AttributeCountLimit = 0
+
+	attrs := trace.WithAttributes(attribute.Int("a", 1), attribute.Int("b", 2))
+	_, span := tp.Tracer("sampled-out").Start(context.Background(), "sampled-out")
+	assert.False(t, span.IsRecording())
+	span.End()
+	_, span = limited.Start(context.Background(), "limited", attrs)
+	span.End()
+	_, span = tp.Tracer("default").Start(context.Background(), "default", attrs)
+	span.End()
+
+	require.Equal(t, 2, te.Len())
+	lim, def := te.Spans()[0], te.Spans()[1]
+	assert.Len(t, lim.Attributes(), 1)
+	assert.Equal(t, 1, lim.DroppedAttributes())
+	assert.Len(t, def.Attributes(), 2)
+}
+
+func TestSetTracerConfigurator(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+	tr := tp.Tracer("toggled")
+
+	disabled := func(instrumentation.Scope) TracerConfig { return TracerConfig{Disabled: true} }
+	tp.SetTracerConfigurator(disabled)
+	_, span := tr.Start(context.Background(), "disabled")
+	assert.False(t, span.IsRecording(), "existing tracer should be disabled")
+	span.End()
+	_, span = tp.Tracer("new").Start(context.Background(), "disabled")
+	assert.False(t, span.IsRecording(), "new tracer should be disabled")
+	span.End()
+
+	tp.SetTracerConfigurator(nil)
+	_, span = tr.Start(context.Background(), "enabled")
+	assert.True(t, span.IsRecording())
+	span.End()
+
+	require.Equal(t, 1, te.Len())
+	assert.Equal(t, "enabled", te.Spans()[0].Name())
+}
+
+func TestSetTracerConfiguratorConcurrentSafe(t *testing.T) {
+	tp := NewTracerProvider()
+	tr := tp.Tracer("concurrent")
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			disabled := i%2 == 0
+			tp.SetTracerConfigurator(func(instrumentation.Scope) TracerConfig {
+				return TracerConfig{Disabled: disabled}
+			})
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_, span := tr.Start(context.Background(), "span")
+			span.SetAttributes(attribute.Int("i", i))
+			span.End()
+		}
+	}()
+	wg.Wait()
+}
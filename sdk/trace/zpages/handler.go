@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages // import "go.opentelemetry.io/otel/sdk/trace/zpages"
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Query parameters of the tracez page.
+const (
+	spanNameParam      = "zspanname"
+	spanTypeParam      = "ztype"
+	latencyBucketParam = "zlatencybucket"
+)
+
+// Types of spans listed by the tracez page.
+const (
+	spanTypeRunning = iota
+	spanTypeLatency
+	spanTypeError
+)
+
+// NewTracezHandler returns an http.Handler rendering the spans of sp as an
+// HTML page. The page summarizes the running spans, the ended spans per
+// latency bucket, and the error spans of each span name, and lists the
+// sampled spans of a selected span name.
+//
+// The handler is safe to be used concurrently with the TracerProvider sp is
+// registered with.
+func NewTracezHandler(sp *SpanProcessor) http.Handler {
+	return &tracezHandler{sp: sp}
+}
+
+type tracezHandler struct {
+	sp *SpanProcessor
+}
+
+type tracezPage struct {
+	LatencyLabels []string
+	Summary       []spanNameSummary
+
+	// The spans exceeding the limits of the SpanProcessor.
+	DroppedRunning uint64
+	DroppedEnded   uint64
+
+	// The sampled spans of the selected span name, if any.
+	SpanName string
+	Title    string
+	Spans    []spanRow
+}
+
+type spanRow struct {
+	Name       string
+	Start      string
+	Duration   string
+	TraceID    string
+	SpanID     string
+	ParentID   string
+	Kind       string
+	Status     string
+	Attributes []string
+	Events     []string
+}
+
+func (h *tracezHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	page := tracezPage{
+		LatencyLabels: latencyLabels,
+		Summary:       h.sp.summary(),
+		SpanName:      r.Form.Get(spanNameParam),
+	}
+	page.DroppedRunning, page.DroppedEnded = h.sp.dropped()
+	if page.SpanName != "" {
+		spanType, _ := strconv.Atoi(r.Form.Get(spanTypeParam))
+		var spans []sdktrace.ReadOnlySpan
+		switch spanType {
+		case spanTypeRunning:
+			page.Title = "Running"
+			spans = h.sp.runningSpans(page.SpanName)
+		case spanTypeLatency:
+			bucket, _ := strconv.Atoi(r.Form.Get(latencyBucketParam))
+			if bucket >= 0 && bucket < len(latencyLabels) {
+				page.Title = "Latency " + latencyLabels[bucket]
+			}
+			spans = h.sp.latencySpans(page.SpanName, bucket)
+		case spanTypeError:
+			page.Title = "Errors"
+			spans = h.sp.errorSpans(page.SpanName)
+		}
+		page.Spans = make([]spanRow, len(spans))
+		for i, s := range spans {
+			page.Spans[i] = newSpanRow(s)
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := tracezTemplate.Execute(w, page); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// latencyLabels are the labels of the latency buckets.
+var latencyLabels = []string{">0s", ">10µs", ">100µs", ">1ms", ">10ms", ">100ms", ">1s", ">10s", ">100s"}
+
+func newSpanRow(s sdktrace.ReadOnlySpan) spanRow {
+	row := spanRow{
+		Name:    s.Name(),
+		Start:   s.StartTime().Format(time.RFC3339Nano),
+		TraceID: s.SpanContext().TraceID().String(),
+		SpanID:  s.SpanContext().SpanID().String(),
+		Kind:    s.SpanKind().String(),
+	}
+	if end := s.EndTime(); end.IsZero() {
+		row.Duration = time.Since(s.StartTime()).String() + " (running)"
+	} else {
+		row.Duration = end.Sub(s.StartTime()).String()
+	}
+	if p := s.Parent(); p.IsValid() {
+		row.ParentID = p.SpanID().String()
+	}
+	row.Status = s.Status().Code.String()
+	if desc := s.Status().Description; desc != "" {
+		row.Status += ": " + desc
+	}
+	for _, a := range s.Attributes() {
+		row.Attributes = append(row.Attributes, string(a.Key)+"="+a.Value.Emit())
+	}
+	for _, e := range s.Events() {
+		row.Events = append(row.Events, fmt.Sprintf("%s %s", e.Time.Format(time.RFC3339Nano), e.Name))
+	}
+	return row
+}
+
+var tracezTemplate = template.Must(template.New("tracez").Funcs(template.FuncMap{
+	"query": func(name string, spanType, bucket int) template.URL {
+		v := url.Values{}
+		v.Set(spanNameParam, name)
+		v.Set(spanTypeParam, strconv.Itoa(spanType))
+		if spanType == spanTypeLatency {
+			v.Set(latencyBucketParam, strconv.Itoa(bucket))
+		}
+		return template.URL("?" + v.Encode()) // nolint:gosec  // The values are encoded.
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>tracez</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 2px 6px; text-align: left; vertical-align: top; }
+</style>
+</head>
+<body>
+<h1>tracez</h1>
+<table>
+<tr><th>Span name</th><th>Running</th>{{range .LatencyLabels}}<th>{{.}}</th>{{end}}<th>Errors</th></tr>
+{{- range $s := .Summary}}
+<tr><td>{{$s.Name}}</td><td><a href="{{query $s.Name 0 0}}">{{$s.Running}}</a></td>
+{{- range $i, $c := $s.Latency}}<td><a href="{{query $s.Name 1 $i}}">{{$c}}</a></td>{{end -}}
+<td><a href="{{query $s.Name 2 0}}">{{$s.Errors}}</a></td></tr>
+{{- end}}
+</table>
+{{- if or .DroppedRunning .DroppedEnded}}
+<p>Not tracked because of the span processor limits: {{.DroppedRunning}} running spans, {{.DroppedEnded}} ended spans.</p>
+{{- end}}
+{{- if .SpanName}}
+<h2>{{.SpanName}}: {{.Title}}</h2>
+<table>
+<tr><th>Start</th><th>Duration</th><th>Trace ID</th><th>Span ID</th><th>Parent span ID</th><th>Kind</th><th>Status</th><th>Attributes</th><th>Events</th></tr>
+{{- range .Spans}}
+<tr><td>{{.Start}}</td><td>{{.Duration}}</td><td>{{.TraceID}}</td><td>{{.SpanID}}</td><td>{{.ParentID}}</td><td>{{.Kind}}</td><td>{{.Status}}</td>
+<td>{{range .Attributes}}{{.}}<br>{{end}}</td><td>{{range .Events}}{{.}}<br>{{end}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
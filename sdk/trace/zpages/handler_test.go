@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestTracezHandler(t *testing.T) {
+	sp := NewSpanProcessor()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sp))
+	tr := tp.Tracer("test")
+	_, running := tr.Start(context.Background(), "op<running>")
+	defer running.End()
+	_, s := tr.Start(context.Background(), "op-error")
+	s.SetStatus(codes.Error, "boom")
+	s.End()
+
+	srv := httptest.NewServer(NewTracezHandler(sp))
+	defer srv.Close()
+	get := func(query url.Values) string {
+		t.Helper()
+		resp, err := http.Get(srv.URL + "?" + query.Encode())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/html; charset=utf-8", resp.Header.Get("Content-Type"))
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	body := get(nil)
+	assert.Contains(t, body, "op&lt;running&gt;", "span names should be escaped")
+	assert.Contains(t, body, "op-error")
+	assert.Contains(t, body, "&gt;100s")
+
+	body = get(url.Values{spanNameParam: {"op<running>"}, spanTypeParam: {strconv.Itoa(spanTypeRunning)}})
+	assert.Contains(t, body, "Running")
+	assert.Contains(t, body, running.SpanContext().SpanID().String())
+
+	body = get(url.Values{spanNameParam: {"op-error"}, spanTypeParam: {strconv.Itoa(spanTypeError)}})
+	assert.Contains(t, body, s.SpanContext().TraceID().String())
+	assert.Contains(t, body, "Error: boom")
+}
+
+func TestLatencyLabels(t *testing.T) {
+	assert.Len(t, latencyLabels, len(latencyBoundaries))
+}
@@ -0,0 +1,342 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package zpages provides an in-process debug page of the spans of a
+// TracerProvider, in the style of the OpenCensus zPages.
+//
+// A SpanProcessor keeps the running spans and samples of the ended spans,
+// and the handler returned by NewTracezHandler renders them as HTML:
+//
+//	sp := zpages.NewSpanProcessor()
+//	tp.RegisterSpanProcessor(sp)
+//	http.Handle("/debug/tracez", zpages.NewTracezHandler(sp))
+package zpages // import "go.opentelemetry.io/otel/sdk/trace/zpages"
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Defaults of the SpanProcessor options.
+const (
+	// DefaultSampleSize is the default number of spans sampled per span
+	// name for the running spans, each latency bucket, and the error spans.
+	DefaultSampleSize = 8
+	// DefaultMaxSpanNames is the default maximum number of span names the
+	// ended spans are sampled for.
+	DefaultMaxSpanNames = 1000
+	// DefaultMaxRunningSpans is the default maximum number of running spans
+	// tracked.
+	DefaultMaxRunningSpans = 10000
+)
+
+// latencyBoundaries are the lower bounds of the latency buckets of the ended
+// spans. A span is in the last bucket whose boundary is lower than or equal
+// to its duration.
+var latencyBoundaries = [...]time.Duration{
+	0,
+	10 * time.Microsecond,
+	100 * time.Microsecond,
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+	100 * time.Second,
+}
+
+// latencyBucket returns the index of the latency bucket of d.
+func latencyBucket(d time.Duration) int {
+	i := sort.Search(len(latencyBoundaries), func(i int) bool {
+		return latencyBoundaries[i] > d
+	})
+	if i == 0 {
+		// Negative durations are in the first bucket.
+		return 0
+	}
+	return i - 1
+}
+
+// Option configures a SpanProcessor.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(c config) config {
+	return fn(c)
+}
+
+type config struct {
+	sampleSize      int
+	maxSpanNames    int
+	maxRunningSpans int
+}
+
+// WithSampleSize returns an Option that configures the number of spans
+// sampled per span name for the running spans, each latency bucket, and the
+// error spans. The memory used for the ended spans of a span name is bounded
+// by this number. If n is not positive, DefaultSampleSize is used.
+func WithSampleSize(n int) Option {
+	return optionFunc(func(c config) config {
+		c.sampleSize = n
+		return c
+	})
+}
+
+// WithMaxSpanNames returns an Option that configures the maximum number of
+// span names the ended spans are sampled for. Ended spans with a new name
+// once the limit is reached are not sampled and counted as dropped. If n is
+// not positive, DefaultMaxSpanNames is used.
+func WithMaxSpanNames(n int) Option {
+	return optionFunc(func(c config) config {
+		c.maxSpanNames = n
+		return c
+	})
+}
+
+// WithMaxRunningSpans returns an Option that configures the maximum number
+// of running spans tracked. Spans started once the limit is reached are not
+// tracked while running and counted as dropped. If n is not positive,
+// DefaultMaxRunningSpans is used.
+func WithMaxRunningSpans(n int) Option {
+	return optionFunc(func(c config) config {
+		c.maxRunningSpans = n
+		return c
+	})
+}
+
+// sampleBucket counts spans and keeps the most recent of them.
+type sampleBucket struct {
+	count   uint64
+	samples []sdktrace.ReadOnlySpan
+	next    int
+}
+
+func (b *sampleBucket) add(s sdktrace.ReadOnlySpan, size int) {
+	b.count++
+	if len(b.samples) < size {
+		b.samples = append(b.samples, s)
+		return
+	}
+	b.samples[b.next] = s
+	b.next = (b.next + 1) % size
+}
+
+// recent returns the samples of b, the most recent first.
+func (b *sampleBucket) recent() []sdktrace.ReadOnlySpan {
+	out := make([]sdktrace.ReadOnlySpan, 0, len(b.samples))
+	for i := 0; i < len(b.samples); i++ {
+		j := (b.next - 1 - i + 2*len(b.samples)) % len(b.samples)
+		out = append(out, b.samples[j])
+	}
+	return out
+}
+
+// endedSpans are the ended spans of a span name.
+type endedSpans struct {
+	latency [len(latencyBoundaries)]sampleBucket
+	errors  sampleBucket
+}
+
+// SpanProcessor is a SpanProcessor that keeps the running spans and samples
+// of the recently ended spans, grouped by span name, to be rendered by the
+// handler returned by NewTracezHandler.
+//
+// Ended spans with an Error status are sampled as error spans, the others
+// are sampled in the latency bucket of their duration. The running spans are
+// referenced until they end.
+//
+// The number of span names and of running spans are bounded, see
+// WithMaxSpanNames and WithMaxRunningSpans. The spans exceeding the bounds
+// are counted by DroppedSpans.
+type SpanProcessor struct {
+	sampleSize      int
+	maxSpanNames    int
+	maxRunningSpans int
+
+	mu             sync.Mutex
+	running        map[trace.SpanID]sdktrace.ReadWriteSpan
+	ended          map[string]*endedSpans
+	droppedRunning uint64
+	droppedEnded   uint64
+}
+
+var _ sdktrace.SpanProcessor = (*SpanProcessor)(nil)
+
+// NewSpanProcessor returns a new SpanProcessor. Register it with
+// TracerProvider.RegisterSpanProcessor or the WithSpanProcessor option.
+func NewSpanProcessor(opts ...Option) *SpanProcessor {
+	var c config
+	for _, opt := range opts {
+		c = opt.apply(c)
+	}
+	if c.sampleSize <= 0 {
+		c.sampleSize = DefaultSampleSize
+	}
+	if c.maxSpanNames <= 0 {
+		c.maxSpanNames = DefaultMaxSpanNames
+	}
+	if c.maxRunningSpans <= 0 {
+		c.maxRunningSpans = DefaultMaxRunningSpans
+	}
+	return &SpanProcessor{
+		sampleSize:      c.sampleSize,
+		maxSpanNames:    c.maxSpanNames,
+		maxRunningSpans: c.maxRunningSpans,
+		running:         make(map[trace.SpanID]sdktrace.ReadWriteSpan),
+		ended:           make(map[string]*endedSpans),
+	}
+}
+
+// OnStart tracks s as a running span, unless the maximum number of running
+// spans is reached.
+func (sp *SpanProcessor) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	id := s.SpanContext().SpanID()
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	if len(sp.running) >= sp.maxRunningSpans {
+		sp.droppedRunning++
+		return
+	}
+	sp.running[id] = s
+}
+
+// OnEnd samples s.
+func (sp *SpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	id := s.SpanContext().SpanID()
+	name := s.Name()
+	isError := s.Status().Code == codes.Error
+	bucket := latencyBucket(s.EndTime().Sub(s.StartTime()))
+
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	delete(sp.running, id)
+	e, ok := sp.ended[name]
+	if !ok {
+		if len(sp.ended) >= sp.maxSpanNames {
+			sp.droppedEnded++
+			return
+		}
+		e = new(endedSpans)
+		sp.ended[name] = e
+	}
+	if isError {
+		e.errors.add(s, sp.sampleSize)
+	} else {
+		e.latency[bucket].add(s, sp.sampleSize)
+	}
+}
+
+// DroppedSpans returns the number of spans not tracked while running because
+// the maximum number of running spans was reached, plus the number of ended
+// spans not sampled because the maximum number of span names was reached.
+func (sp *SpanProcessor) DroppedSpans() uint64 {
+	running, ended := sp.dropped()
+	return running + ended
+}
+
+// dropped returns the number of running and ended spans dropped.
+func (sp *SpanProcessor) dropped() (running, ended uint64) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	return sp.droppedRunning, sp.droppedEnded
+}
+
+// Shutdown does nothing.
+func (sp *SpanProcessor) Shutdown(context.Context) error { return nil }
+
+// ForceFlush does nothing.
+func (sp *SpanProcessor) ForceFlush(context.Context) error { return nil }
+
+// spanNameSummary is the summary of the spans of a span name.
+type spanNameSummary struct {
+	Name    string
+	Running int
+	Latency [len(latencyBoundaries)]uint64
+	Errors  uint64
+}
+
+// summary returns the summary of all span names, sorted by name.
+func (sp *SpanProcessor) summary() []spanNameSummary {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	byName := make(map[string]*spanNameSummary, len(sp.ended))
+	get := func(name string) *spanNameSummary {
+		s, ok := byName[name]
+		if !ok {
+			s = &spanNameSummary{Name: name}
+			byName[name] = s
+		}
+		return s
+	}
+	for _, s := range sp.running {
+		get(s.Name()).Running++
+	}
+	for name, e := range sp.ended {
+		s := get(name)
+		for i := range e.latency {
+			s.Latency[i] = e.latency[i].count
+		}
+		s.Errors = e.errors.count
+	}
+
+	out := make([]spanNameSummary, 0, len(byName))
+	for _, s := range byName {
+		out = append(out, *s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// runningSpans returns at most the sample size of the running spans named
+// name, the most recently started first.
+func (sp *SpanProcessor) runningSpans(name string) []sdktrace.ReadOnlySpan {
+	sp.mu.Lock()
+	var out []sdktrace.ReadOnlySpan
+	for _, s := range sp.running {
+		if s.Name() == name {
+			out = append(out, s)
+		}
+	}
+	sp.mu.Unlock()
+
+	sort.Slice(out, func(i, j int) bool { return out[i].StartTime().After(out[j].StartTime()) })
+	if len(out) > sp.sampleSize {
+		out = out[:sp.sampleSize]
+	}
+	return out
+}
+
+// latencySpans returns the sampled spans named name in the latency bucket,
+// the most recently ended first.
+func (sp *SpanProcessor) latencySpans(name string, bucket int) []sdktrace.ReadOnlySpan {
+	if bucket < 0 || bucket >= len(latencyBoundaries) {
+		return nil
+	}
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	if e, ok := sp.ended[name]; ok {
+		return e.latency[bucket].recent()
+	}
+	return nil
+}
+
+// errorSpans returns the sampled error spans named name, the most recently
+// ended first.
+func (sp *SpanProcessor) errorSpans(name string) []sdktrace.ReadOnlySpan {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	if e, ok := sp.ended[name]; ok {
+		return e.errors.recent()
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLatencyBucket(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want int
+	}{
+		{-time.Second, 0},
+		{0, 0},
+		{9 * time.Microsecond, 0},
+		{10 * time.Microsecond, 1},
+		{5 * time.Millisecond, 3},
+		{time.Second, 6},
+		{time.Hour, len(latencyBoundaries) - 1},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, latencyBucket(test.d), test.d)
+	}
+}
+
+func TestSpanProcessor(t *testing.T) {
+	sp := NewSpanProcessor(WithSampleSize(2))
+	tp := sdktrace.NewTracerProvider()
+	tp.RegisterSpanProcessor(sp)
+	tr := tp.Tracer("test")
+
+	start := time.Now()
+	_, running := tr.Start(context.Background(), "running", trace.WithTimestamp(start))
+	for i := 0; i < 3; i++ {
+		_, s := tr.Start(context.Background(), "fast", trace.WithTimestamp(start))
+		s.SetAttributes(attribute.Int("i", i))
+		s.End(trace.WithTimestamp(start.Add(time.Millisecond)))
+	}
+	_, s := tr.Start(context.Background(), "fast", trace.WithTimestamp(start))
+	s.SetStatus(codes.Error, "failed")
+	s.End(trace.WithTimestamp(start.Add(time.Millisecond)))
+
+	assert.Equal(t, []spanNameSummary{
+		{Name: "fast", Latency: [len(latencyBoundaries)]uint64{3: 3}, Errors: 1},
+		{Name: "running", Running: 1},
+	}, sp.summary())
+
+	latency := sp.latencySpans("fast", 3)
+	require.Len(t, latency, 2, "samples should be bounded")
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("i", 2)}, latency[0].Attributes())
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("i", 1)}, latency[1].Attributes())
+	assert.Empty(t, sp.latencySpans("fast", 0))
+	assert.Empty(t, sp.latencySpans("fast", -1))
+	assert.Empty(t, sp.latencySpans("fast", len(latencyBoundaries)))
+	assert.Len(t, sp.errorSpans("fast"), 1)
+	assert.Len(t, sp.runningSpans("running"), 1)
+
+	running.End()
+	assert.Empty(t, sp.runningSpans("running"))
+}
+
+func TestSpanProcessorLimits(t *testing.T) {
+	sp := NewSpanProcessor(WithMaxSpanNames(2), WithMaxRunningSpans(1))
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sp))
+	tr := tp.Tracer("test")
+
+	_, a := tr.Start(context.Background(), "a")
+	_, b := tr.Start(context.Background(), "b")
+	assert.Len(t, sp.runningSpans("a"), 1)
+	assert.Empty(t, sp.runningSpans("b"), "running spans should be bounded")
+	a.End()
+	b.End()
+
+	_, c := tr.Start(context.Background(), "c")
+	c.End()
+	_, a = tr.Start(context.Background(), "a")
+	a.End()
+
+	summary := sp.summary()
+	require.Len(t, summary, 2, "span names should be bounded")
+	assert.Equal(t, "a", summary[0].Name)
+	assert.Equal(t, "b", summary[1].Name)
+
+	running, ended := sp.dropped()
+	assert.Equal(t, uint64(1), running)
+	assert.Equal(t, uint64(1), ended)
+	assert.Equal(t, uint64(2), sp.DroppedSpans())
+}